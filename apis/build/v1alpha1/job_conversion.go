@@ -0,0 +1,40 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/kloops-io/kloops/apis/build/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// SetupWebhookWithManager registers the Job conversion webhook with the manager
+func (r *Job) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(r).Complete()
+}
+
+var _ conversion.Convertible = &Job{}
+
+// ConvertTo converts this Job to the hub version (v1beta1)
+func (r *Job) ConvertTo(hub conversion.Hub) error {
+	return Convert_v1alpha1_Job_To_v1beta1_Job(r, hub.(*v1beta1.Job), nil)
+}
+
+// ConvertFrom converts from the hub version (v1beta1) to this version
+func (r *Job) ConvertFrom(hub conversion.Hub) error {
+	return Convert_v1beta1_Job_To_v1alpha1_Job(hub.(*v1beta1.Job), r, nil)
+}
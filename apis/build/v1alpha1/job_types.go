@@ -38,6 +38,8 @@ type JobStatus struct {
 	// Important: Run "make" to regenerate code after modifying this file
 }
 
+// +genclient
+// +genclient:noStatus
 // +kubebuilder:object:root=true
 
 // Job is the Schema for the jobs API
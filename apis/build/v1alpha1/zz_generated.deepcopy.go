@@ -112,3 +112,143 @@ func (in *JobStatus) DeepCopy() *JobStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MergeCriteria) DeepCopyInto(out *MergeCriteria) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MissingLabels != nil {
+		in, out := &in.MissingLabels, &out.MissingLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MergeCriteria.
+func (in *MergeCriteria) DeepCopy() *MergeCriteria {
+	if in == nil {
+		return nil
+	}
+	out := new(MergeCriteria)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MergeJobResult) DeepCopyInto(out *MergeJobResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MergeJobResult.
+func (in *MergeJobResult) DeepCopy() *MergeJobResult {
+	if in == nil {
+		return nil
+	}
+	out := new(MergeJobResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MergeRecord) DeepCopyInto(out *MergeRecord) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MergeRecord.
+func (in *MergeRecord) DeepCopy() *MergeRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(MergeRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MergeRecord) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MergeRecordList) DeepCopyInto(out *MergeRecordList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MergeRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MergeRecordList.
+func (in *MergeRecordList) DeepCopy() *MergeRecordList {
+	if in == nil {
+		return nil
+	}
+	out := new(MergeRecordList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MergeRecordList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MergeRecordSpec) DeepCopyInto(out *MergeRecordSpec) {
+	*out = *in
+	if in.PullRequests != nil {
+		in, out := &in.PullRequests, &out.PullRequests
+		*out = make([]MergedPullRequest, len(*in))
+		copy(*out, *in)
+	}
+	in.Criteria.DeepCopyInto(&out.Criteria)
+	if in.Jobs != nil {
+		in, out := &in.Jobs, &out.Jobs
+		*out = make([]MergeJobResult, len(*in))
+		copy(*out, *in)
+	}
+	in.MergedAt.DeepCopyInto(&out.MergedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MergeRecordSpec.
+func (in *MergeRecordSpec) DeepCopy() *MergeRecordSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MergeRecordSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MergedPullRequest) DeepCopyInto(out *MergedPullRequest) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MergedPullRequest.
+func (in *MergedPullRequest) DeepCopy() *MergedPullRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(MergedPullRequest)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,116 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	configv1beta1 "github.com/kloops-io/kloops/apis/config/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RepoConfigLabel is the label holding the name of the RepoConfig a merge record belongs to
+const RepoConfigLabel = "build.kloops.io/repoconfig"
+
+// MergedPullRequest defines a pull request merged by the bot
+type MergedPullRequest struct {
+	// Number is the pull request number
+	Number int `json:"number"`
+	// Title is the pull request title
+	Title string `json:"title,omitempty"`
+	// Author is the pull request author login
+	Author string `json:"author,omitempty"`
+	// HeadSHA is the pull request head commit sha at merge time
+	HeadSHA string `json:"headSHA"`
+}
+
+// MergeCriteria is a snapshot of the auto merge criteria in effect at merge time
+type MergeCriteria struct {
+	// Labels are the labels required on pull requests for merging
+	Labels []string `json:"labels,omitempty"`
+	// MissingLabels are the labels that must not be present on pull requests for merging
+	MissingLabels []string `json:"missingLabels,omitempty"`
+	// ReviewApprovedRequired tells that review must be approved on pull requests for merging
+	ReviewApprovedRequired bool `json:"reviewApprovedRequired,omitempty"`
+}
+
+// MergeJobResult defines the result of a job that triggered the merge
+type MergeJobResult struct {
+	// Name is the job name (or status context)
+	Name string `json:"name"`
+	// State is the job state
+	State string `json:"state"`
+	// URL is the job details url
+	URL string `json:"url,omitempty"`
+}
+
+// MergeRecordSpec defines the desired state of MergeRecord
+type MergeRecordSpec struct {
+	// Repo is the repository full name (owner/repo)
+	Repo string `json:"repo"`
+	// BaseRef is the branch pull requests were merged into
+	BaseRef string `json:"baseRef"`
+	// BaseSHABefore is the base branch sha before merging
+	BaseSHABefore string `json:"baseSHABefore"`
+	// BaseSHAAfter is the base branch sha after merging
+	BaseSHAAfter string `json:"baseSHAAfter,omitempty"`
+	// PullRequests are the pull requests merged, more than one for a batch merge
+	PullRequests []MergedPullRequest `json:"pullRequests"`
+	// MergeType is the merge method used
+	MergeType configv1beta1.PullRequestMergeType `json:"mergeType"`
+	// Criteria is the auto merge criteria in effect at merge time
+	Criteria MergeCriteria `json:"criteria"`
+	// Jobs are the results of the jobs that triggered the merge
+	Jobs []MergeJobResult `json:"jobs,omitempty"`
+	// MergedAt is the merge time
+	MergedAt metav1.Time `json:"mergedAt"`
+	// DryRun tells that the merge was only simulated and the repository was not modified
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// IsBatch tells whether the record is a batch merge
+func (s *MergeRecordSpec) IsBatch() bool {
+	return len(s.PullRequests) > 1
+}
+
+// +genclient
+// +genclient:noStatus
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name=repo,JSONPath=.spec.repo,type=string
+// +kubebuilder:printcolumn:name=base,JSONPath=.spec.baseRef,type=string
+// +kubebuilder:printcolumn:name=type,JSONPath=.spec.mergeType,type=string
+// +kubebuilder:printcolumn:name=dry-run,JSONPath=.spec.dryRun,type=boolean
+// +kubebuilder:printcolumn:name=age,JSONPath=.metadata.creationTimestamp,type=date
+
+// MergeRecord is the Schema for the mergerecords API
+type MergeRecord struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              MergeRecordSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MergeRecordList contains a list of MergeRecord
+type MergeRecordList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MergeRecord `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MergeRecord{}, &MergeRecordList{})
+}
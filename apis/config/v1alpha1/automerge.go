@@ -0,0 +1,164 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Default needs rebase label and comment
+const (
+	DefaultNeedsRebaseLabel   = "needs-rebase"
+	DefaultNeedsRebaseComment = "PR needs rebase."
+)
+
+// MergeTypeFor returns the merge type to use for a pull request targeting the given branch.
+// A merge type label on the pull request takes precedence over the branch and default merge types.
+func (a *AutoMerge) MergeTypeFor(branch string, labels []string) (PullRequestMergeType, error) {
+	mergeType := a.MergeType
+	if t, ok := a.BranchMergeTypes[branch]; ok {
+		mergeType = t
+	}
+	var fromLabel PullRequestMergeType
+	for _, label := range labels {
+		if !strings.HasPrefix(label, MergeTypeLabelPrefix) {
+			continue
+		}
+		t := PullRequestMergeType(strings.TrimPrefix(label, MergeTypeLabelPrefix))
+		if !t.IsValid() {
+			return "", fmt.Errorf("invalid merge type label %s", label)
+		}
+		if fromLabel != "" && fromLabel != t {
+			return "", fmt.Errorf("conflicting merge type labels %s and %s", fromLabel.Label(), label)
+		}
+		fromLabel = t
+	}
+	if fromLabel != "" {
+		mergeType = fromLabel
+	}
+	if !mergeType.IsValid() {
+		return "", fmt.Errorf("invalid merge type %s for branch %s", mergeType, branch)
+	}
+	return mergeType, nil
+}
+
+// Priority returns the priority of a pull request in the merge queue given its labels, lower is more urgent.
+// Pull requests without a priority label get the lowest priority.
+func (a *AutoMerge) Priority(labels []string) int {
+	for i, priorityLabel := range a.PriorityLabels {
+		for _, label := range labels {
+			if label == priorityLabel {
+				return i
+			}
+		}
+	}
+	return len(a.PriorityLabels)
+}
+
+// PriorityLabel returns the priority label of a pull request given its labels, empty when it has none
+func (a *AutoMerge) PriorityLabel(labels []string) string {
+	if p := a.Priority(labels); p < len(a.PriorityLabels) {
+		return a.PriorityLabels[p]
+	}
+	return ""
+}
+
+// Before tells whether a pull request must come before another one in the merge queue,
+// comparing priorities first and breaking ties with the pull requests creation time.
+func (a *AutoMerge) Before(labels []string, created time.Time, otherLabels []string, otherCreated time.Time) bool {
+	p, otherP := a.Priority(labels), a.Priority(otherLabels)
+	if p != otherP {
+		return p < otherP
+	}
+	return created.Before(otherCreated)
+}
+
+// QueueDescription returns the merge status context description of a pull request at the given queue position (0 based)
+func (a *AutoMerge) QueueDescription(labels []string, position, length int) string {
+	description := fmt.Sprintf("In merge queue (%d/%d)", position+1, length)
+	if label := a.PriorityLabel(labels); label != "" {
+		description += fmt.Sprintf(", priority %s", label)
+	}
+	return description
+}
+
+// Render renders the commit title and body templates against the given data.
+// Empty templates render to empty strings, leaving the choice to the git server.
+func (t *MergeCommitTemplate) Render(data interface{}) (string, string, error) {
+	if t == nil {
+		return "", "", nil
+	}
+	title, err := renderTemplate("merge commit title", t.Title, data)
+	if err != nil {
+		return "", "", err
+	}
+	body, err := renderTemplate("merge commit body", t.Body, data)
+	if err != nil {
+		return "", "", err
+	}
+	return title, body, nil
+}
+
+func renderTemplate(name, text string, data interface{}) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+	tmpl, err := template.New(name).Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse %s template: %w", name, err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to execute %s template: %w", name, err)
+	}
+	return buf.String(), nil
+}
+
+// GetLabel returns the needs rebase label
+func (n *NeedsRebase) GetLabel() string {
+	if n.Label == "" {
+		return DefaultNeedsRebaseLabel
+	}
+	return n.Label
+}
+
+// LabelChanges tells whether the needs rebase label must be added to or removed from
+// a pull request, given its current labels and whether it has merge conflicts.
+// A comment should be posted when the label gets added.
+func (n *NeedsRebase) LabelChanges(labels []string, conflicts bool) (bool, bool) {
+	label := n.GetLabel()
+	hasLabel := false
+	for _, l := range labels {
+		if l == label {
+			hasLabel = true
+			break
+		}
+	}
+	return conflicts && !hasLabel, !conflicts && hasLabel
+}
+
+// RenderComment renders the comment posted on pull requests with merge conflicts
+func (n *NeedsRebase) RenderComment(data interface{}) (string, error) {
+	if n.CommentTemplate == "" {
+		return DefaultNeedsRebaseComment, nil
+	}
+	return renderTemplate("needs rebase comment", n.CommentTemplate, data)
+}
@@ -0,0 +1,156 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestMergeTypeFor(t *testing.T) {
+	a := &AutoMerge{MergeType: MergeMerge, BranchMergeTypes: map[string]PullRequestMergeType{"release": MergeRebase}}
+	tests := []struct {
+		name    string
+		branch  string
+		labels  []string
+		want    PullRequestMergeType
+		wantErr bool
+	}{
+		{name: "default", branch: "master", want: MergeMerge},
+		{name: "branch", branch: "release", want: MergeRebase},
+		{name: "label overrides the branch", branch: "release", labels: []string{"lgtm", MergeTypeLabelPrefix + "squash"}, want: MergeSquash},
+		{name: "same label twice", branch: "master", labels: []string{MergeTypeLabelPrefix + "squash", MergeTypeLabelPrefix + "squash"}, want: MergeSquash},
+		{name: "invalid label", branch: "master", labels: []string{MergeTypeLabelPrefix + "octopus"}, wantErr: true},
+		{name: "conflicting labels", branch: "master", labels: []string{MergeTypeLabelPrefix + "squash", MergeTypeLabelPrefix + "rebase"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := a.MergeTypeFor(tt.branch, tt.labels)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MergeTypeFor() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("MergeTypeFor() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeQueueOrder(t *testing.T) {
+	a := &AutoMerge{PriorityLabels: []string{"critical", "high"}}
+	now := time.Now()
+	type pr struct {
+		name    string
+		labels  []string
+		created time.Time
+	}
+	queue := []pr{
+		{name: "old", created: now.Add(-3 * time.Hour)},
+		{name: "new high", labels: []string{"high"}, created: now.Add(-time.Hour)},
+		{name: "critical", labels: []string{"lgtm", "critical"}, created: now},
+		{name: "old high", labels: []string{"high"}, created: now.Add(-2 * time.Hour)},
+		{name: "critical and high", labels: []string{"high", "critical"}, created: now.Add(time.Hour)},
+	}
+	sort.SliceStable(queue, func(i, j int) bool {
+		return a.Before(queue[i].labels, queue[i].created, queue[j].labels, queue[j].created)
+	})
+	var got []string
+	for _, pr := range queue {
+		got = append(got, pr.name)
+	}
+	if want := []string{"critical", "critical and high", "old high", "new high", "old"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("queue = %v, want %v", got, want)
+	}
+	if got, want := a.QueueDescription([]string{"high"}, 1, 5), "In merge queue (2/5), priority high"; got != want {
+		t.Errorf("QueueDescription() = %q, want %q", got, want)
+	}
+	if got, want := a.QueueDescription(nil, 4, 5), "In merge queue (5/5)"; got != want {
+		t.Errorf("QueueDescription() = %q, want %q", got, want)
+	}
+}
+
+func TestMergeCommitTemplateRender(t *testing.T) {
+	data := struct {
+		Number int
+		Title  string
+	}{Number: 12, Title: "Fix the build"}
+	tests := []struct {
+		name      string
+		template  *MergeCommitTemplate
+		wantTitle string
+		wantBody  string
+		wantErr   bool
+	}{
+		{name: "no template"},
+		{name: "title only", template: &MergeCommitTemplate{Title: "{{ .Title }} (#{{ .Number }})"}, wantTitle: "Fix the build (#12)"},
+		{name: "title and body", template: &MergeCommitTemplate{Title: "{{ .Title }}", Body: "Closes #{{ .Number }}"}, wantTitle: "Fix the build", wantBody: "Closes #12"},
+		{name: "unknown field", template: &MergeCommitTemplate{Title: "{{ .Author }}"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			title, body, err := tt.template.Render(data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Render() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if title != tt.wantTitle || body != tt.wantBody {
+				t.Errorf("Render() = %q, %q, want %q, %q", title, body, tt.wantTitle, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestNeedsRebase(t *testing.T) {
+	tests := []struct {
+		name        string
+		needsRebase NeedsRebase
+		labels      []string
+		conflicts   bool
+		wantAdd     bool
+		wantRemove  bool
+		wantComment string
+	}{
+		{name: "conflicts", labels: []string{"lgtm"}, conflicts: true, wantAdd: true, wantComment: DefaultNeedsRebaseComment},
+		{name: "already labelled", labels: []string{DefaultNeedsRebaseLabel}, conflicts: true, wantComment: DefaultNeedsRebaseComment},
+		{name: "conflicts resolved", labels: []string{DefaultNeedsRebaseLabel}, wantRemove: true, wantComment: DefaultNeedsRebaseComment},
+		{name: "no conflicts", wantComment: DefaultNeedsRebaseComment},
+		{
+			name:        "custom label and comment",
+			needsRebase: NeedsRebase{Label: "conflicts", CommentTemplate: "#{{ .Number }} has conflicts"},
+			labels:      []string{DefaultNeedsRebaseLabel},
+			conflicts:   true,
+			wantAdd:     true,
+			wantComment: "#12 has conflicts",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			add, remove := tt.needsRebase.LabelChanges(tt.labels, tt.conflicts)
+			if add != tt.wantAdd || remove != tt.wantRemove {
+				t.Errorf("LabelChanges() = %v, %v, want %v, %v", add, remove, tt.wantAdd, tt.wantRemove)
+			}
+			comment, err := tt.needsRebase.RenderComment(struct{ Number int }{12})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if comment != tt.wantComment {
+				t.Errorf("RenderComment() = %q, want %q", comment, tt.wantComment)
+			}
+		})
+	}
+}
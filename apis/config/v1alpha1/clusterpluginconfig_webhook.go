@@ -0,0 +1,36 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// SetupWebhookWithManager registers the ClusterPluginConfig webhooks with the manager
+func (r *ClusterPluginConfig) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(r).Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-config-kloops-io-v1alpha1-clusterpluginconfig,mutating=true,failurePolicy=fail,groups=config.kloops.io,resources=clusterpluginconfigs,verbs=create;update,versions=v1alpha1,name=mclusterpluginconfig.kloops.io
+
+var _ webhook.Defaulter = &ClusterPluginConfig{}
+
+// Default implements webhook.Defaulter
+func (r *ClusterPluginConfig) Default() {
+	r.Spec.Default()
+}
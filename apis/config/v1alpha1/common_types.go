@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // PullRequestMergeType inidicates the type of the pull request
@@ -30,11 +31,33 @@ const (
 	MergeSquash PullRequestMergeType = "squash"
 )
 
+// MergeTypeLabelPrefix is the prefix of the labels used to override the merge type of a pull request
+const MergeTypeLabelPrefix = "tide/merge-method-"
+
 // IsValid checks that the merge type is valid
 func (c PullRequestMergeType) IsValid() bool {
 	return c == MergeMerge || c == MergeRebase || c == MergeSquash
 }
 
+// Label returns the pull request label used to select the merge type
+func (c PullRequestMergeType) Label() string {
+	return MergeTypeLabelPrefix + string(c)
+}
+
+// UpdateBranchMethod indicates how a pull request branch is updated with its base branch
+type UpdateBranchMethod string
+
+// Possible methods to update a pull request branch
+const (
+	UpdateBranchMerge  UpdateBranchMethod = "merge"
+	UpdateBranchRebase UpdateBranchMethod = "rebase"
+)
+
+// IsValid checks that the update branch method is valid
+func (c UpdateBranchMethod) IsValid() bool {
+	return c == UpdateBranchMerge || c == UpdateBranchRebase
+}
+
 // Secret defines a secret
 type Secret struct {
 	// Refers to a non-secret value
@@ -43,7 +66,60 @@ type Secret struct {
 	ValueFrom *ValueFrom `json:"valueFrom,omitempty"`
 }
 
-// ValueFrom defines a reference to a secret
+// IsEmpty returns true if the secret defines neither a value nor a reference
+func (s Secret) IsEmpty() bool {
+	return s.Value == "" && s.ValueFrom == nil
+}
+
+// ValueFrom defines a reference to a secret, exactly one of the references must be set
 type ValueFrom struct {
-	SecretKeyRef corev1.SecretKeySelector `json:"secretKeyRef"`
+	// SecretKeyRef selects a key of a kubernetes secret
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+	// FileRef reads the value from a file, usually mounted in the pod, the file is read again when it changes.
+	// Only files in the directories allowed by the operator can be read.
+	FileRef *FileRef `json:"fileRef,omitempty"`
+	// EnvRef reads the value from an environment variable of the process.
+	// Only the variables allowed by the operator can be read.
+	EnvRef *EnvRef `json:"envRef,omitempty"`
+	// ExternalRef reads the value from an external secret store
+	ExternalRef *ExternalRef `json:"externalRef,omitempty"`
+}
+
+// FileRef defines a reference to a file
+type FileRef struct {
+	// Path is the absolute path of the file
+	Path string `json:"path"`
+}
+
+// EnvRef defines a reference to an environment variable
+type EnvRef struct {
+	// Name is the name of the environment variable
+	Name string `json:"name"`
+}
+
+// ExternalRef defines a reference to a value of an external secret store
+type ExternalRef struct {
+	// Provider is the name of the secret store provider (vault)
+	Provider string `json:"provider"`
+	// Path is the path of the secret in the store, relative to the path of the namespace secrets
+	Path string `json:"path"`
+	// Key is the key of the value in the secret
+	Key string `json:"key"`
+}
+
+// ConditionType is the type of a condition
+type ConditionType string
+
+// Condition defines an observation of a resource state
+type Condition struct {
+	// Type is the type of the condition
+	Type ConditionType `json:"type"`
+	// Status is the status of the condition, one of True, False, Unknown
+	Status corev1.ConditionStatus `json:"status"`
+	// LastTransitionTime is the last time the condition transitioned from one status to another
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// Reason is a machine readable explanation for the condition last transition
+	Reason string `json:"reason,omitempty"`
+	// Message is a human readable explanation for the condition last transition
+	Message string `json:"message,omitempty"`
 }
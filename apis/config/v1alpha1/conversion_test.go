@@ -0,0 +1,103 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/kloops-io/kloops/apis/config/v1beta1"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/diff"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// fuzzIterations is the number of fuzzed objects converted per kind
+const fuzzIterations = 200
+
+// convertibles returns a new pair of spoke and hub objects for every kind of the group
+func convertibles() map[string]func() (conversion.Convertible, conversion.Hub) {
+	return map[string]func() (conversion.Convertible, conversion.Hub){
+		"RepoConfig":   func() (conversion.Convertible, conversion.Hub) { return &RepoConfig{}, &v1beta1.RepoConfig{} },
+		"OrgConfig":    func() (conversion.Convertible, conversion.Hub) { return &OrgConfig{}, &v1beta1.OrgConfig{} },
+		"PluginConfig": func() (conversion.Convertible, conversion.Hub) { return &PluginConfig{}, &v1beta1.PluginConfig{} },
+		"ClusterPluginConfig": func() (conversion.Convertible, conversion.Hub) {
+			return &ClusterPluginConfig{}, &v1beta1.ClusterPluginConfig{}
+		},
+	}
+}
+
+func TestFuzzRoundTripSpoke(t *testing.T) {
+	f := newFuzzer(t)
+	for kind, newObjects := range convertibles() {
+		t.Run(kind, func(t *testing.T) {
+			for i := 0; i < fuzzIterations; i++ {
+				spoke, hub := newObjects()
+				f.Fuzz(spoke)
+				if err := spoke.ConvertTo(hub); err != nil {
+					t.Fatalf("ConvertTo() error = %v", err)
+				}
+				roundTrip, _ := newObjects()
+				if err := roundTrip.ConvertFrom(hub); err != nil {
+					t.Fatalf("ConvertFrom() error = %v", err)
+				}
+				if !apiequality.Semantic.DeepEqual(spoke, roundTrip) {
+					t.Fatalf("v1alpha1 -> v1beta1 -> v1alpha1 round trip changed the object:\n%s", diff.ObjectReflectDiff(spoke, roundTrip))
+				}
+			}
+		})
+	}
+}
+
+func TestFuzzRoundTripHub(t *testing.T) {
+	f := newFuzzer(t)
+	for kind, newObjects := range convertibles() {
+		t.Run(kind, func(t *testing.T) {
+			for i := 0; i < fuzzIterations; i++ {
+				spoke, hub := newObjects()
+				f.Fuzz(hub)
+				if err := spoke.ConvertFrom(hub); err != nil {
+					t.Fatalf("ConvertFrom() error = %v", err)
+				}
+				_, roundTrip := newObjects()
+				if err := spoke.ConvertTo(roundTrip); err != nil {
+					t.Fatalf("ConvertTo() error = %v", err)
+				}
+				if !apiequality.Semantic.DeepEqual(hub, roundTrip) {
+					t.Fatalf("v1beta1 -> v1alpha1 -> v1beta1 round trip changed the object:\n%s", diff.ObjectReflectDiff(hub, roundTrip))
+				}
+			}
+		})
+	}
+}
+
+func newFuzzer(t *testing.T) interface{ Fuzz(interface{}) } {
+	seed := rand.Int63()
+	t.Logf("fuzzer seed %d", seed)
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1beta1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return fuzzer.FuzzerFor(metafuzzer.Funcs, rand.NewSource(seed), serializer.NewCodecFactory(scheme))
+}
@@ -0,0 +1,215 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// Owner returns the organization the config applies to
+func (s *OrgConfigSpec) Owner() string {
+	switch {
+	case s.GitHub != nil:
+		return s.GitHub.Owner
+	case s.Gitea != nil:
+		return s.Gitea.Owner
+	case s.GitLab != nil:
+		return s.GitLab.Group
+	case s.BitbucketServer != nil:
+		return s.BitbucketServer.Project
+	}
+	return ""
+}
+
+// Matches returns true if the config applies to the given repository of the organization
+func (s *OrgConfigSpec) Matches(repo string) bool {
+	for _, pattern := range s.Exclude {
+		if ok, _ := path.Match(pattern, repo); ok {
+			return false
+		}
+	}
+	if len(s.Include) == 0 {
+		return true
+	}
+	for _, pattern := range s.Include {
+		if ok, _ := path.Match(pattern, repo); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckRepo returns an error if the config cannot apply to the repository defined in the given repo config spec.
+// The git server must be the same, the repository must belong to the organization and match the Include and Exclude patterns.
+func (s *OrgConfigSpec) CheckRepo(repo *RepoConfigSpec) error {
+	kind, serverURL := s.GitServer()
+	repoKind, repoServerURL := repo.GitServer()
+	if kind != repoKind {
+		return fmt.Errorf("repository git server %s differs from the org config git server %s", repoKind, kind)
+	}
+	if repoServerURL != "" && repoServerURL != serverURL {
+		return fmt.Errorf("repository server url %s differs from the org config server url %s", repoServerURL, serverURL)
+	}
+	owner, name := repo.Repository()
+	if owner != s.Owner() {
+		return fmt.Errorf("repository %s/%s does not belong to %s", owner, name, s.Owner())
+	}
+	if !s.Matches(name) {
+		return fmt.Errorf("repository %s/%s is not matched by the org config include and exclude patterns", owner, name)
+	}
+	return nil
+}
+
+// GitServer returns the kind and url of the org git server
+func (s *OrgConfigSpec) GitServer() (string, string) {
+	switch {
+	case s.GitHub != nil:
+		return "github", s.GitHub.ServerURL
+	case s.Gitea != nil:
+		return "gitea", s.Gitea.ServerURL
+	case s.GitLab != nil:
+		return "gitlab", s.GitLab.ServerURL
+	case s.BitbucketServer != nil:
+		return "bitbucketServer", s.BitbucketServer.ServerURL
+	}
+	return "", ""
+}
+
+// GitServer returns the kind and url of the repository git server
+func (s *RepoConfigSpec) GitServer() (string, string) {
+	switch {
+	case s.GitHub != nil:
+		return "github", s.GitHub.ServerURL
+	case s.Gitea != nil:
+		return "gitea", s.Gitea.ServerURL
+	case s.GitLab != nil:
+		return "gitlab", s.GitLab.ServerURL
+	case s.BitbucketServer != nil:
+		return "bitbucketServer", s.BitbucketServer.ServerURL
+	}
+	return "", ""
+}
+
+// ForRepo returns the repo config spec the config defines for the given repository of the organization
+func (s *OrgConfigSpec) ForRepo(repo string) RepoConfigSpec {
+	spec := RepoConfigSpec{
+		BotName:      s.BotName,
+		PluginConfig: *s.PluginConfig.DeepCopy(),
+	}
+	if s.AutoMerge != nil {
+		spec.AutoMerge = s.AutoMerge.DeepCopy()
+	}
+	switch {
+	case s.GitHub != nil:
+		org := s.GitHub.DeepCopy()
+		spec.GitHub = &GitHubRepo{Owner: org.Owner, Repo: repo, ServerURL: org.ServerURL, HmacToken: org.HmacToken, Token: org.Token, App: org.App}
+	case s.Gitea != nil:
+		org := s.Gitea.DeepCopy()
+		spec.Gitea = &GiteaRepo{Owner: org.Owner, Repo: repo, ServerURL: org.ServerURL, HmacToken: org.HmacToken, Token: org.Token}
+	case s.GitLab != nil:
+		group := s.GitLab.DeepCopy()
+		spec.GitLab = &GitLabRepo{Project: group.Group + "/" + repo, ServerURL: group.ServerURL, WebhookToken: group.WebhookToken, Token: group.Token}
+	case s.BitbucketServer != nil:
+		project := s.BitbucketServer.DeepCopy()
+		spec.BitbucketServer = &BitbucketServerRepo{Project: project.Project, Repo: repo, ServerURL: project.ServerURL, HmacToken: project.HmacToken, Token: project.Token}
+	}
+	return spec
+}
+
+// Repository returns the owner and name of the repository the config applies to
+func (s *RepoConfigSpec) Repository() (owner, repo string) {
+	switch {
+	case s.GitHub != nil:
+		return s.GitHub.Owner, s.GitHub.Repo
+	case s.Gitea != nil:
+		return s.Gitea.Owner, s.Gitea.Repo
+	case s.GitLab != nil:
+		if i := strings.LastIndex(s.GitLab.Project, "/"); i >= 0 {
+			return s.GitLab.Project[:i], s.GitLab.Project[i+1:]
+		}
+		return "", s.GitLab.Project
+	case s.BitbucketServer != nil:
+		return s.BitbucketServer.Project, s.BitbucketServer.Repo
+	}
+	return "", ""
+}
+
+// Override returns a copy of the spec with the non empty settings of the given spec merged on top of it.
+// Auto merge, inline plugin and config updater configurations are replaced as a whole,
+// enabled and disabled plugins are added to or removed from the inherited ones.
+func (s *RepoConfigSpec) Override(override *RepoConfigSpec) RepoConfigSpec {
+	spec := *s.DeepCopy()
+	override = override.DeepCopy()
+	if override.BotName != "" {
+		spec.BotName = override.BotName
+	}
+	if spec.GitHub != nil && override.GitHub != nil {
+		overrideString(&spec.GitHub.ServerURL, override.GitHub.ServerURL)
+		overrideSecret(&spec.GitHub.HmacToken, override.GitHub.HmacToken)
+		if !override.GitHub.Token.IsEmpty() || override.GitHub.App != nil {
+			spec.GitHub.Token, spec.GitHub.App = override.GitHub.Token, override.GitHub.App
+		}
+	}
+	if spec.Gitea != nil && override.Gitea != nil {
+		overrideString(&spec.Gitea.ServerURL, override.Gitea.ServerURL)
+		overrideSecret(&spec.Gitea.HmacToken, override.Gitea.HmacToken)
+		overrideSecret(&spec.Gitea.Token, override.Gitea.Token)
+	}
+	if spec.GitLab != nil && override.GitLab != nil {
+		overrideString(&spec.GitLab.ServerURL, override.GitLab.ServerURL)
+		overrideSecret(&spec.GitLab.WebhookToken, override.GitLab.WebhookToken)
+		overrideSecret(&spec.GitLab.Token, override.GitLab.Token)
+	}
+	if spec.BitbucketServer != nil && override.BitbucketServer != nil {
+		overrideString(&spec.BitbucketServer.ServerURL, override.BitbucketServer.ServerURL)
+		overrideSecret(&spec.BitbucketServer.HmacToken, override.BitbucketServer.HmacToken)
+		overrideSecret(&spec.BitbucketServer.Token, override.BitbucketServer.Token)
+	}
+	if override.AutoMerge != nil {
+		spec.AutoMerge = override.AutoMerge
+	}
+	if override.PluginConfig.Ref != "" || len(override.PluginConfig.Refs) != 0 || override.PluginConfig.Spec != nil {
+		plugins, disabledPlugins, pluginEvents := spec.PluginConfig.Plugins, spec.PluginConfig.DisabledPlugins, spec.PluginConfig.PluginEvents
+		spec.PluginConfig = override.PluginConfig
+		spec.PluginConfig.Plugins, spec.PluginConfig.DisabledPlugins, spec.PluginConfig.PluginEvents = plugins, disabledPlugins, pluginEvents
+	}
+	spec.PluginConfig.overridePlugins(&override.PluginConfig)
+	if len(override.BranchProtection) != 0 {
+		spec.BranchProtection = override.BranchProtection
+	}
+	if override.LabelSync != nil {
+		spec.LabelSync = override.LabelSync
+	}
+	if override.ConfigUpdater != nil {
+		spec.ConfigUpdater = override.ConfigUpdater
+	}
+	return spec
+}
+
+func overrideString(value *string, override string) {
+	if override != "" {
+		*value = override
+	}
+}
+
+func overrideSecret(secret *Secret, override Secret) {
+	if !override.IsEmpty() {
+		*secret = override
+	}
+}
@@ -0,0 +1,108 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"path"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// SetupWebhookWithManager registers the OrgConfig webhooks with the manager
+func (r *OrgConfig) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(r).Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-config-kloops-io-v1alpha1-orgconfig,mutating=true,failurePolicy=fail,groups=config.kloops.io,resources=orgconfigs,verbs=create;update,versions=v1alpha1,name=morgconfig.kloops.io
+
+var _ webhook.Defaulter = &OrgConfig{}
+
+// Default implements webhook.Defaulter
+func (r *OrgConfig) Default() {
+	if r.Spec.GitHub != nil && r.Spec.GitHub.ServerURL == "" {
+		r.Spec.GitHub.ServerURL = DefaultGitHubServerURL
+	}
+	if r.Spec.GitLab != nil && r.Spec.GitLab.ServerURL == "" {
+		r.Spec.GitLab.ServerURL = DefaultGitLabServerURL
+	}
+	if r.Spec.AutoMerge != nil && r.Spec.AutoMerge.MergeType == "" {
+		r.Spec.AutoMerge.MergeType = MergeMerge
+	}
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-config-kloops-io-v1alpha1-orgconfig,mutating=false,failurePolicy=fail,groups=config.kloops.io,resources=orgconfigs,versions=v1alpha1,name=vorgconfig.kloops.io
+
+var _ webhook.Validator = &OrgConfig{}
+
+// ValidateCreate implements webhook.Validator
+func (r *OrgConfig) ValidateCreate() error {
+	return r.validate()
+}
+
+// ValidateUpdate implements webhook.Validator
+func (r *OrgConfig) ValidateUpdate(old runtime.Object) error {
+	return r.validate()
+}
+
+// ValidateDelete implements webhook.Validator
+func (r *OrgConfig) ValidateDelete() error {
+	return nil
+}
+
+func (r *OrgConfig) validate() error {
+	allErrs := r.Spec.validate(field.NewPath("spec"))
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(GroupVersion.WithKind("OrgConfig").GroupKind(), r.Name, allErrs)
+}
+
+func (s *OrgConfigSpec) validate(path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateProviders(path, s.GitHub != nil, s.Gitea != nil, s.GitLab != nil, s.BitbucketServer != nil)...)
+	if s.GitHub != nil {
+		allErrs = append(allErrs, validateGitHubCredentials(path.Child("gitHub"), s.GitHub.Token, s.GitHub.App, true)...)
+	}
+	if s.GitLab != nil && s.GitLab.Group == "" {
+		allErrs = append(allErrs, field.Required(path.Child("gitLab", "group"), "group path must be set"))
+	}
+	if s.BitbucketServer != nil && s.BitbucketServer.ServerURL == "" {
+		allErrs = append(allErrs, field.Required(path.Child("bitbucketServer", "server"), "server url must be set"))
+	}
+	allErrs = append(allErrs, validatePatterns(path.Child("include"), s.Include)...)
+	allErrs = append(allErrs, validatePatterns(path.Child("exclude"), s.Exclude)...)
+	if s.AutoMerge != nil {
+		kind, _ := s.GitServer()
+		allErrs = append(allErrs, s.AutoMerge.validate(path.Child("autoMerge"), kind)...)
+	}
+	allErrs = append(allErrs, s.PluginConfig.validate(path.Child("pluginConfig"))...)
+	return allErrs
+}
+
+func validatePatterns(fldPath *field.Path, patterns []string) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), pattern, err.Error()))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,165 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// RefKind returns the kind of the referenced config
+func (r *PluginConfigRef) RefKind() string {
+	if r.Kind == "" {
+		return PluginConfigKind
+	}
+	return r.Kind
+}
+
+// Key returns the key of the referenced config, namespace is the namespace of the referencing object.
+// The namespace of the key is empty for a ClusterPluginConfig.
+func (r *PluginConfigRef) Key(namespace string) types.NamespacedName {
+	switch {
+	case r.RefKind() == ClusterPluginConfigKind:
+		namespace = ""
+	case r.Namespace != "":
+		namespace = r.Namespace
+	}
+	return types.NamespacedName{Namespace: namespace, Name: r.Name}
+}
+
+// Describe describes the referenced config for messages, namespace is the namespace of the referencing object
+func (r *PluginConfigRef) Describe(namespace string) string {
+	key := r.Key(namespace)
+	if key.Namespace == "" {
+		return r.RefKind() + " " + key.Name
+	}
+	return r.RefKind() + " " + key.String()
+}
+
+// AllRefs returns the referenced configs in merge order, ref first then refs
+func (p *RepoPluginConfig) AllRefs() []PluginConfigRef {
+	var refs []PluginConfigRef
+	if p.Ref != "" {
+		refs = append(refs, PluginConfigRef{Name: p.Ref, Kind: p.Kind, Namespace: p.Namespace})
+	}
+	return append(refs, p.Refs...)
+}
+
+// Merge merges the overlay spec on top of the spec: secrets override when set, lists and the welcome
+// message template override when not nil (an empty list clears the inherited one), size thresholds override as a whole
+func (s *PluginConfigSpec) Merge(overlay *PluginConfigSpec) {
+	overrideStrings(&s.Owners.MDYAMLRepos, overlay.Owners.MDYAMLRepos)
+	overrideStrings(&s.Owners.SkipCollaborators, overlay.Owners.SkipCollaborators)
+	overrideStrings(&s.Owners.LabelsExcludeList, overlay.Owners.LabelsExcludeList)
+	overrideSecret(&s.Cat.Key, overlay.Cat.Key)
+	overrideSecret(&s.Goose.Key, overlay.Goose.Key)
+	overrideStrings(&s.Label.Prefixes, overlay.Label.Prefixes)
+	overrideStrings(&s.Label.AdditionalLabels, overlay.Label.AdditionalLabels)
+	// size thresholds depend on each other, they are never merged individually
+	if overlay.Size != (Size{}) {
+		s.Size = overlay.Size
+	}
+	if overlay.Welcome.MessageTemplate != nil {
+		template := *overlay.Welcome.MessageTemplate
+		s.Welcome.MessageTemplate = &template
+	}
+}
+
+// overrideStrings replaces the list when the override is not nil, an empty override clears it
+func overrideStrings(value *[]string, override []string) {
+	if override != nil {
+		*value = append([]string{}, override...)
+	}
+}
+
+// EnabledPlugins returns the sorted enabled plugins, the given defaults plus the enabled plugins minus the disabled ones
+func (p *RepoPluginConfig) EnabledPlugins(defaults []string) []string {
+	enabled := map[string]bool{}
+	for _, plugin := range defaults {
+		enabled[plugin] = true
+	}
+	for _, plugin := range p.Plugins {
+		enabled[plugin] = true
+	}
+	for _, plugin := range p.DisabledPlugins {
+		delete(enabled, plugin)
+	}
+	plugins := make([]string, 0, len(enabled))
+	for plugin := range enabled {
+		plugins = append(plugins, plugin)
+	}
+	sort.Strings(plugins)
+	return plugins
+}
+
+// HandlesEvent tells whether the event type is allowed for the plugin, all event types are allowed when the plugin is not restricted
+func (p *RepoPluginConfig) HandlesEvent(plugin string, event PluginEventType) bool {
+	for _, pluginEvents := range p.PluginEvents {
+		if pluginEvents.Plugin != plugin {
+			continue
+		}
+		for _, e := range pluginEvents.Events {
+			if e == event {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// overridePlugins merges the plugin enablement of the override on top of the config,
+// plugins are added to or removed from the inherited ones and event restrictions are replaced per plugin
+func (p *RepoPluginConfig) overridePlugins(override *RepoPluginConfig) {
+	enabled, disabled := map[string]bool{}, map[string]bool{}
+	for _, plugin := range override.Plugins {
+		enabled[plugin] = true
+	}
+	for _, plugin := range override.DisabledPlugins {
+		disabled[plugin] = true
+	}
+	var plugins, disabledPlugins []string
+	for _, plugin := range p.Plugins {
+		if !disabled[plugin] && !enabled[plugin] {
+			plugins = append(plugins, plugin)
+		}
+	}
+	for _, plugin := range p.DisabledPlugins {
+		if !enabled[plugin] && !disabled[plugin] {
+			disabledPlugins = append(disabledPlugins, plugin)
+		}
+	}
+	p.Plugins = append(plugins, override.Plugins...)
+	p.DisabledPlugins = append(disabledPlugins, override.DisabledPlugins...)
+	overridden := map[string]bool{}
+	for _, pluginEvents := range override.PluginEvents {
+		overridden[pluginEvents.Plugin] = true
+	}
+	var pluginEvents []PluginEvents
+	for _, e := range p.PluginEvents {
+		if !overridden[e.Plugin] {
+			pluginEvents = append(pluginEvents, e)
+		}
+	}
+	p.PluginEvents = append(pluginEvents, override.PluginEvents...)
+}
+
+// IsValid checks that the event type is valid
+func (e PluginEventType) IsValid() bool {
+	return e == PluginEventPush || e == PluginEventPullRequest || e == PluginEventComment
+}
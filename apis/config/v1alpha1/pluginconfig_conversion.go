@@ -0,0 +1,34 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/kloops-io/kloops/apis/config/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+var _ conversion.Convertible = &PluginConfig{}
+
+// ConvertTo converts this PluginConfig to the hub version (v1beta1)
+func (r *PluginConfig) ConvertTo(hub conversion.Hub) error {
+	return Convert_v1alpha1_PluginConfig_To_v1beta1_PluginConfig(r, hub.(*v1beta1.PluginConfig), nil)
+}
+
+// ConvertFrom converts from the hub version (v1beta1) to this version
+func (r *PluginConfig) ConvertFrom(hub conversion.Hub) error {
+	return Convert_v1beta1_PluginConfig_To_v1alpha1_PluginConfig(hub.(*v1beta1.PluginConfig), r, nil)
+}
@@ -0,0 +1,70 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPluginConfigSpecMerge(t *testing.T) {
+	template := "welcome"
+	empty := ""
+	base := PluginConfigSpec{
+		Label:   Label{Prefixes: []string{"area"}, AdditionalLabels: []string{"bug"}},
+		Size:    Size{S: 10, M: 30, L: 100, Xl: 500, Xxl: 1000},
+		Welcome: Welcome{MessageTemplate: &template},
+		Cat:     Cat{Key: Secret{Value: "key"}},
+	}
+	tests := []struct {
+		name    string
+		overlay PluginConfigSpec
+		want    func(*PluginConfigSpec)
+	}{
+		{
+			name:    "unset values are inherited",
+			overlay: PluginConfigSpec{},
+			want:    func(*PluginConfigSpec) {},
+		},
+		{
+			name:    "empty lists clear",
+			overlay: PluginConfigSpec{Label: Label{Prefixes: []string{}}},
+			want:    func(s *PluginConfigSpec) { s.Label.Prefixes = []string{} },
+		},
+		{
+			name:    "empty template overrides",
+			overlay: PluginConfigSpec{Welcome: Welcome{MessageTemplate: &empty}},
+			want:    func(s *PluginConfigSpec) { s.Welcome.MessageTemplate = &empty },
+		},
+		{
+			name:    "size overrides as a whole",
+			overlay: PluginConfigSpec{Size: Size{S: 1, M: 2, L: 3, Xl: 4, Xxl: 5}},
+			want:    func(s *PluginConfigSpec) { s.Size = Size{S: 1, M: 2, L: 3, Xl: 4, Xxl: 5} },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := base.DeepCopy()
+			got.Merge(&tt.overlay)
+			want := base.DeepCopy()
+			tt.want(want)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Merge() = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
@@ -20,7 +20,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// PluginConfigSpec defines the desired state of PluginConfig
+// PluginConfigSpec defines the desired state of PluginConfig.
+// When plugin configs are merged, lists left unset (null) are inherited while an empty list clears the inherited one,
+// the welcome message template is inherited when unset and an empty template overrides it.
+// Secrets and size thresholds cannot be cleared, unset (empty or zero) values are inherited.
 type PluginConfigSpec struct {
 	// Owners contains configuration related to handling OWNERS files.
 	Owners Owners `json:"owners,omitempty"`
@@ -46,8 +49,10 @@ type Goose struct {
 
 // Label contains the configuration for the label plugin.
 type Label struct {
+	// Prefixes is the set of label prefixes enabled for use, defaults to "area", "kind" and "priority".
+	Prefixes []string `json:"prefixes"`
 	// AdditionalLabels is a set of additional labels enabled for use
-	// on top of the existing "kind/*", "priority/*", and "area/*" labels.
+	// on top of the labels matching the prefixes.
 	AdditionalLabels []string `json:"additionalLabels"`
 }
 
@@ -64,7 +69,7 @@ type Size struct {
 // Welcome contains the configuration for the welcome plugin.
 type Welcome struct {
 	// MessageTemplate is the welcome message template to post on new-contributor PRs
-	MessageTemplate string `json:"messageTemplate,omitempty"`
+	MessageTemplate *string `json:"messageTemplate,omitempty"`
 }
 
 // Owners contains configuration related to handling OWNERS files.
@@ -81,22 +86,50 @@ type Owners struct {
 		- thockin
 		---
 	*/
-	MDYAMLRepos []string `json:"mdyamlrepos,omitempty"`
+	MDYAMLRepos []string `json:"mdyamlrepos"`
 	// SkipCollaborators disables collaborator cross-checks and forces both
 	// the approve and lgtm plugins to use solely OWNERS files for access
 	// control in the provided repos.
-	SkipCollaborators []string `json:"skipCollaborators,omitempty"`
+	SkipCollaborators []string `json:"skipCollaborators"`
 	// LabelsExcludeList holds a list of labels that should not be present in any
 	// OWNERS file, preventing their automatic addition by the owners-label plugin.
 	// This check is performed by the verify-owners plugin.
-	LabelsExcludeList []string `json:"labelsExcludes,omitempty"`
+	LabelsExcludeList []string `json:"labelsExcludes"`
+}
+
+// PluginConfig condition types
+const (
+	// PluginConfigReady tells that the plugin config is valid and its secrets resolve
+	PluginConfigReady ConditionType = "Ready"
+	// PluginConfigValid tells that the plugin settings are valid
+	PluginConfigValid ConditionType = "Valid"
+	// PluginConfigSecretsResolved tells that the plugin secrets resolve
+	PluginConfigSecretsResolved ConditionType = "SecretsResolved"
+)
+
+// PluginConfigConsumer defines an object referencing a plugin config
+type PluginConfigConsumer struct {
+	// Kind is the kind of the referencing object, RepoConfig or OrgConfig
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the referencing object
+	Namespace string `json:"namespace"`
+	// Name is the name of the referencing object
+	Name string `json:"name"`
 }
 
 // PluginConfigStatus defines the observed state of PluginConfig
 type PluginConfigStatus struct {
+	// ObservedGeneration is the last generation reconciled by the controller
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Consumers are the repo configs and org configs referencing the plugin config
+	Consumers []PluginConfigConsumer `json:"consumers,omitempty"`
+	// Conditions are the observations of the plugin config state
+	Conditions []Condition `json:"conditions,omitempty"`
 }
 
+// +genclient
 // +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name=ready,JSONPath=.status.conditions[?(@.type=="Ready")].status,type=string
 // +kubebuilder:printcolumn:name=age,JSONPath=.metadata.creationTimestamp,type=date
 // +kubebuilder:subresource:status
 
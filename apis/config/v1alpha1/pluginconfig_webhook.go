@@ -0,0 +1,83 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// DefaultSize holds the standard size plugin thresholds
+var DefaultSize = Size{
+	S:   10,
+	M:   30,
+	L:   100,
+	Xl:  500,
+	Xxl: 1000,
+}
+
+// DefaultLabelPrefixes are the label prefixes enabled by default in the label plugin
+var DefaultLabelPrefixes = []string{"area", "kind", "priority"}
+
+// SetupWebhookWithManager registers the PluginConfig webhooks with the manager
+func (r *PluginConfig) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(r).Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-config-kloops-io-v1alpha1-pluginconfig,mutating=true,failurePolicy=fail,groups=config.kloops.io,resources=pluginconfigs,verbs=create;update,versions=v1alpha1,name=mpluginconfig.kloops.io
+
+var _ webhook.Defaulter = &PluginConfig{}
+
+// Default implements webhook.Defaulter
+func (r *PluginConfig) Default() {
+	r.Spec.Default()
+}
+
+// Default fills the omitted plugin settings with their default values.
+// Size thresholds are defaulted as a whole, a partially set Size is left for validation to reject.
+func (s *PluginConfigSpec) Default() {
+	// size thresholds depend on each other, they are only defaulted when none is set
+	if s.Size == (Size{}) {
+		s.Size = DefaultSize
+	}
+	// an empty list explicitly disables prefixes
+	if s.Label.Prefixes == nil {
+		s.Label.Prefixes = append([]string(nil), DefaultLabelPrefixes...)
+	}
+}
+
+// Validate checks the defaulted plugin settings, size thresholds must be strictly increasing and templates must parse
+func (s *PluginConfigSpec) Validate(path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	thresholds := []struct {
+		name  string
+		value int
+	}{{"s", s.Size.S}, {"m", s.Size.M}, {"l", s.Size.L}, {"xl", s.Size.Xl}, {"xxl", s.Size.Xxl}}
+	for i, threshold := range thresholds {
+		switch {
+		case threshold.value <= 0:
+			allErrs = append(allErrs, field.Invalid(path.Child("size", threshold.name), threshold.value, "must be greater than 0"))
+		case i > 0 && threshold.value <= thresholds[i-1].value:
+			allErrs = append(allErrs, field.Invalid(path.Child("size", threshold.name), threshold.value, "must be greater than "+thresholds[i-1].name))
+		}
+	}
+	if s.Welcome.MessageTemplate != nil && *s.Welcome.MessageTemplate != "" {
+		allErrs = append(allErrs, validateTemplate(path.Child("welcome", "messageTemplate"), *s.Welcome.MessageTemplate)...)
+	}
+	return allErrs
+}
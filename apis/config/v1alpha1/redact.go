@@ -0,0 +1,63 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import "reflect"
+
+// RedactedValue replaces inline secret values redacted from objects shown to users
+const RedactedValue = "<redacted>"
+
+// RedactSecrets replaces the inline values of all the secrets of the given object (a pointer) with RedactedValue.
+// Secret references are kept, they do not hold secret values.
+func RedactSecrets(obj interface{}) {
+	redact(reflect.ValueOf(obj))
+}
+
+var secretType = reflect.TypeOf(Secret{})
+
+func redact(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			redact(v.Elem())
+		}
+	case reflect.Struct:
+		if v.Type() == secretType {
+			if value := v.FieldByName("Value"); value.String() != "" && value.CanSet() {
+				value.SetString(RedactedValue)
+			}
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath == "" {
+				redact(v.Field(i))
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			redact(v.Index(i))
+		}
+	case reflect.Map:
+		for _, key := range v.MapKeys() {
+			// map values are not addressable, they are redacted on a copy
+			value := reflect.New(v.Type().Elem()).Elem()
+			value.Set(v.MapIndex(key))
+			redact(value)
+			v.SetMapIndex(key, value)
+		}
+	}
+}
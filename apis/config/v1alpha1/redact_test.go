@@ -0,0 +1,42 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import "testing"
+
+func TestRedactSecrets(t *testing.T) {
+	ref := &ValueFrom{EnvRef: &EnvRef{Name: "TOKEN"}}
+	spec := &RepoConfigSpec{
+		GitHub: &GitHubRepo{Token: Secret{Value: "token"}, HmacToken: Secret{ValueFrom: ref}},
+		PluginConfig: RepoPluginConfig{
+			Spec: &PluginConfigSpec{Cat: Cat{Key: Secret{Value: "cat"}}},
+		},
+	}
+	RedactSecrets(spec)
+	if spec.GitHub.Token.Value != RedactedValue {
+		t.Errorf("token value = %q, want redacted", spec.GitHub.Token.Value)
+	}
+	if spec.GitHub.HmacToken.Value != "" || spec.GitHub.HmacToken.ValueFrom != ref {
+		t.Errorf("hmac token reference changed: %+v", spec.GitHub.HmacToken)
+	}
+	if spec.PluginConfig.Spec.Cat.Key.Value != RedactedValue {
+		t.Errorf("cat key value = %q, want redacted", spec.PluginConfig.Spec.Cat.Key.Value)
+	}
+	if spec.PluginConfig.Spec.Goose.Key.Value != "" {
+		t.Errorf("empty goose key value = %q, want empty", spec.PluginConfig.Spec.Goose.Key.Value)
+	}
+}
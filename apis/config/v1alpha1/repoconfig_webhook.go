@@ -0,0 +1,355 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// SetupWebhookWithManager registers the RepoConfig webhooks with the manager
+func (r *RepoConfig) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(r).Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-config-kloops-io-v1alpha1-repoconfig,mutating=true,failurePolicy=fail,groups=config.kloops.io,resources=repoconfigs,verbs=create;update,versions=v1alpha1,name=mrepoconfig.kloops.io
+
+var _ webhook.Defaulter = &RepoConfig{}
+
+// Default implements webhook.Defaulter
+func (r *RepoConfig) Default() {
+	// server urls are inherited from the org config when set
+	if r.Spec.GitHub != nil && r.Spec.GitHub.ServerURL == "" && r.Spec.OrgConfig == "" {
+		r.Spec.GitHub.ServerURL = DefaultGitHubServerURL
+	}
+	if r.Spec.GitLab != nil && r.Spec.GitLab.ServerURL == "" && r.Spec.OrgConfig == "" {
+		r.Spec.GitLab.ServerURL = DefaultGitLabServerURL
+	}
+	if r.Spec.AutoMerge != nil && r.Spec.AutoMerge.MergeType == "" {
+		r.Spec.AutoMerge.MergeType = MergeMerge
+	}
+	if r.Spec.ConfigUpdater != nil && r.Spec.ConfigUpdater.Branch == "" {
+		r.Spec.ConfigUpdater.Branch = DefaultConfigUpdaterBranch
+	}
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-config-kloops-io-v1alpha1-repoconfig,mutating=false,failurePolicy=fail,groups=config.kloops.io,resources=repoconfigs,versions=v1alpha1,name=vrepoconfig.kloops.io
+
+var _ webhook.Validator = &RepoConfig{}
+
+// ValidateCreate implements webhook.Validator
+func (r *RepoConfig) ValidateCreate() error {
+	return r.validate()
+}
+
+// ValidateUpdate implements webhook.Validator
+func (r *RepoConfig) ValidateUpdate(old runtime.Object) error {
+	return r.validate()
+}
+
+// ValidateDelete implements webhook.Validator
+func (r *RepoConfig) ValidateDelete() error {
+	return nil
+}
+
+func (r *RepoConfig) validate() error {
+	allErrs := r.Spec.validate(field.NewPath("spec"))
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(GroupVersion.WithKind("RepoConfig").GroupKind(), r.Name, allErrs)
+}
+
+func (s *RepoConfigSpec) validate(path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateProviders(path, s.GitHub != nil, s.Gitea != nil, s.GitLab != nil, s.BitbucketServer != nil)...)
+	if s.GitHub != nil {
+		allErrs = append(allErrs, validateGitHubCredentials(path.Child("gitHub"), s.GitHub.Token, s.GitHub.App, s.OrgConfig == "")...)
+	}
+	if s.GitLab != nil && s.GitLab.Project == "" {
+		allErrs = append(allErrs, field.Required(path.Child("gitLab", "project"), "project path must be set"))
+	}
+	if s.BitbucketServer != nil && s.BitbucketServer.ServerURL == "" && s.OrgConfig == "" {
+		allErrs = append(allErrs, field.Required(path.Child("bitbucketServer", "server"), "server url must be set"))
+	}
+	if s.AutoMerge != nil {
+		kind, _ := s.GitServer()
+		allErrs = append(allErrs, s.AutoMerge.validate(path.Child("autoMerge"), kind)...)
+	}
+	allErrs = append(allErrs, s.PluginConfig.validate(path.Child("pluginConfig"))...)
+	branches := map[string]bool{}
+	for i, protection := range s.BranchProtection {
+		allErrs = append(allErrs, protection.validate(path.Child("branchProtection").Index(i), s.Gitea != nil)...)
+		if branches[protection.Branch] {
+			allErrs = append(allErrs, field.Duplicate(path.Child("branchProtection").Index(i).Child("branch"), protection.Branch))
+		}
+		branches[protection.Branch] = true
+	}
+	if s.LabelSync != nil {
+		allErrs = append(allErrs, s.LabelSync.validate(path.Child("labelSync"))...)
+	}
+	if s.ConfigUpdater != nil {
+		allErrs = append(allErrs, s.ConfigUpdater.validate(path.Child("configUpdater"))...)
+	}
+	return allErrs
+}
+
+func (l *LabelSync) validate(path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	names := map[string]bool{}
+	for i, label := range l.Labels {
+		labelPath := path.Child("labels").Index(i)
+		if label.Name == "" {
+			allErrs = append(allErrs, field.Required(labelPath.Child("name"), "label name must be set"))
+		}
+		if !labelColor.MatchString(label.Color) {
+			allErrs = append(allErrs, field.Invalid(labelPath.Child("color"), label.Color, "must be a 6 digits hex color code without the leading #"))
+		}
+		for j, name := range append([]string{label.Name}, label.PreviousNames...) {
+			key := strings.ToLower(name)
+			if names[key] {
+				namePath := labelPath.Child("name")
+				if j > 0 {
+					namePath = labelPath.Child("previousNames").Index(j - 1)
+				}
+				allErrs = append(allErrs, field.Duplicate(namePath, name))
+			}
+			names[key] = true
+		}
+	}
+	return allErrs
+}
+
+func (p *BranchProtection) validate(path *field.Path, gitea bool) field.ErrorList {
+	var allErrs field.ErrorList
+	if p.Branch == "" {
+		allErrs = append(allErrs, field.Required(path.Child("branch"), "branch name must be set"))
+	}
+	if p.RequiredApprovals < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("requiredApprovals"), p.RequiredApprovals, "must be greater than or equal to 0"))
+	}
+	if gitea && p.EnforceAdmins {
+		allErrs = append(allErrs, field.Forbidden(path.Child("enforceAdmins"), "not supported by gitea"))
+	}
+	return allErrs
+}
+
+// validateProviders checks that exactly one of gitHub, gitea, gitLab or bitbucketServer is set
+func validateProviders(path *field.Path, gitHub, gitea, gitLab, bitbucketServer bool) field.ErrorList {
+	var providers []string
+	if gitHub {
+		providers = append(providers, "gitHub")
+	}
+	if gitea {
+		providers = append(providers, "gitea")
+	}
+	if gitLab {
+		providers = append(providers, "gitLab")
+	}
+	if bitbucketServer {
+		providers = append(providers, "bitbucketServer")
+	}
+	switch {
+	case len(providers) == 0:
+		return field.ErrorList{field.Required(path, "one of gitHub, gitea, gitLab or bitbucketServer must be set")}
+	case len(providers) > 1:
+		return field.ErrorList{field.Forbidden(path.Child(providers[1]), fmt.Sprintf("%s are mutually exclusive", strings.Join(providers, " and ")))}
+	}
+	return nil
+}
+
+// validateGitHubCredentials checks the GitHub token and app settings, required is false when credentials can be inherited
+func validateGitHubCredentials(path *field.Path, token Secret, app *GitHubApp, required bool) field.ErrorList {
+	var allErrs field.ErrorList
+	switch {
+	case !token.IsEmpty() && app != nil:
+		allErrs = append(allErrs, field.Forbidden(path.Child("app"), "token and app are mutually exclusive"))
+	case required && token.IsEmpty() && app == nil:
+		allErrs = append(allErrs, field.Required(path, "one of token or app must be set"))
+	}
+	if app != nil {
+		if app.AppID <= 0 {
+			allErrs = append(allErrs, field.Required(path.Child("app", "appID"), "app id must be set"))
+		}
+		if app.InstallationID <= 0 {
+			allErrs = append(allErrs, field.Required(path.Child("app", "installationID"), "installation id must be set"))
+		}
+	}
+	return allErrs
+}
+
+// updateBranchMethods are the pull request branch update methods supported by each git server kind
+var updateBranchMethods = map[string][]string{
+	"github":          {string(UpdateBranchMerge)},
+	"gitea":           {string(UpdateBranchMerge), string(UpdateBranchRebase)},
+	"gitlab":          {string(UpdateBranchRebase)},
+	"bitbucketServer": nil,
+}
+
+// validate checks the auto merge settings, gitServer is the kind of git server of the repositories
+func (a *AutoMerge) validate(path *field.Path, gitServer string) field.ErrorList {
+	var allErrs field.ErrorList
+	if a.BatchSizeLimit < -1 {
+		allErrs = append(allErrs, field.Invalid(path.Child("batchSizeLimit"), a.BatchSizeLimit, "must be greater than or equal to -1"))
+	}
+	if !a.MergeType.IsValid() {
+		allErrs = append(allErrs, field.NotSupported(path.Child("mergeType"), a.MergeType, mergeTypes))
+	}
+	for branch, mergeType := range a.BranchMergeTypes {
+		if !mergeType.IsValid() {
+			allErrs = append(allErrs, field.NotSupported(path.Child("branchMergeTypes").Key(branch), mergeType, mergeTypes))
+		}
+	}
+	if a.MergeCommitTemplate != nil {
+		allErrs = append(allErrs, validateTemplate(path.Child("mergeCommitTemplate", "title"), a.MergeCommitTemplate.Title)...)
+		allErrs = append(allErrs, validateTemplate(path.Child("mergeCommitTemplate", "body"), a.MergeCommitTemplate.Body)...)
+	}
+	if a.UpdateBranch != nil {
+		switch methods, known := updateBranchMethods[gitServer]; {
+		case !a.UpdateBranch.Method.IsValid():
+			allErrs = append(allErrs, field.NotSupported(path.Child("updateBranch", "method"), a.UpdateBranch.Method, []string{string(UpdateBranchMerge), string(UpdateBranchRebase)}))
+		case known && len(methods) == 0:
+			allErrs = append(allErrs, field.Forbidden(path.Child("updateBranch"), fmt.Sprintf("not supported by %s", gitServer)))
+		case known && !containsString(methods, string(a.UpdateBranch.Method)):
+			allErrs = append(allErrs, field.NotSupported(path.Child("updateBranch", "method"), a.UpdateBranch.Method, methods))
+		}
+	}
+	if a.NeedsRebase != nil {
+		// the needs rebase label cannot be added to bitbucket server pull requests
+		if gitServer == "bitbucketServer" {
+			allErrs = append(allErrs, field.Forbidden(path.Child("needsRebase"), "not supported by bitbucketServer"))
+		}
+		allErrs = append(allErrs, validateTemplate(path.Child("needsRebase", "commentTemplate"), a.NeedsRebase.CommentTemplate)...)
+	}
+	if a.History != nil && a.History.MaxRecords < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("history", "maxRecords"), a.History.MaxRecords, "must be greater than or equal to 0"))
+	}
+	return allErrs
+}
+
+func (p *RepoPluginConfig) validate(path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if p.Ref == "" {
+		if p.Kind != "" {
+			allErrs = append(allErrs, field.Forbidden(path.Child("kind"), "kind requires ref"))
+		}
+		if p.Namespace != "" {
+			allErrs = append(allErrs, field.Forbidden(path.Child("namespace"), "namespace requires ref"))
+		}
+	} else {
+		allErrs = append(allErrs, validatePluginConfigRef(path, PluginConfigRef{Name: p.Ref, Kind: p.Kind, Namespace: p.Namespace})...)
+	}
+	for i, ref := range p.Refs {
+		if ref.Name == "" {
+			allErrs = append(allErrs, field.Required(path.Child("refs").Index(i).Child("name"), "name is required"))
+		}
+		allErrs = append(allErrs, validatePluginConfigRef(path.Child("refs").Index(i), ref)...)
+	}
+	enabled := map[string]bool{}
+	for i, plugin := range p.Plugins {
+		if enabled[plugin] {
+			allErrs = append(allErrs, field.Duplicate(path.Child("plugins").Index(i), plugin))
+		}
+		enabled[plugin] = true
+	}
+	disabled := map[string]bool{}
+	for i, plugin := range p.DisabledPlugins {
+		switch {
+		case disabled[plugin]:
+			allErrs = append(allErrs, field.Duplicate(path.Child("disabledPlugins").Index(i), plugin))
+		case enabled[plugin]:
+			allErrs = append(allErrs, field.Invalid(path.Child("disabledPlugins").Index(i), plugin, "plugin is both enabled and disabled"))
+		}
+		disabled[plugin] = true
+	}
+	restricted := map[string]bool{}
+	for i, pluginEvents := range p.PluginEvents {
+		if restricted[pluginEvents.Plugin] {
+			allErrs = append(allErrs, field.Duplicate(path.Child("pluginEvents").Index(i).Child("plugin"), pluginEvents.Plugin))
+		}
+		restricted[pluginEvents.Plugin] = true
+		if len(pluginEvents.Events) == 0 {
+			allErrs = append(allErrs, field.Required(path.Child("pluginEvents").Index(i).Child("events"), "at least one event type is required"))
+		}
+		for j, event := range pluginEvents.Events {
+			if !event.IsValid() {
+				allErrs = append(allErrs, field.NotSupported(path.Child("pluginEvents").Index(i).Child("events").Index(j), event, pluginEventTypes))
+			}
+		}
+	}
+	return allErrs
+}
+
+func validatePluginConfigRef(path *field.Path, ref PluginConfigRef) field.ErrorList {
+	var allErrs field.ErrorList
+	switch ref.Kind {
+	case "", PluginConfigKind:
+	case ClusterPluginConfigKind:
+		if ref.Namespace != "" {
+			allErrs = append(allErrs, field.Forbidden(path.Child("namespace"), "ClusterPluginConfig is cluster scoped"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(path.Child("kind"), ref.Kind, []string{PluginConfigKind, ClusterPluginConfigKind}))
+	}
+	return allErrs
+}
+
+func (c *ConfigUpdater) validate(path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(c.Paths) == 0 {
+		allErrs = append(allErrs, field.Required(path.Child("paths"), "at least one path is required"))
+	}
+	if c.ServiceAccountName == "" {
+		allErrs = append(allErrs, field.Required(path.Child("serviceAccountName"), "the config updater must impersonate a service account"))
+	}
+	for i, p := range c.Paths {
+		if strings.HasPrefix(p, "/") || p == ".." || strings.HasPrefix(p, "../") || strings.Contains(p, "/../") || strings.HasSuffix(p, "/..") {
+			allErrs = append(allErrs, field.Invalid(path.Child("paths").Index(i), p, "must be relative to the repository root"))
+		}
+	}
+	return allErrs
+}
+
+var labelColor = regexp.MustCompile("^[0-9a-fA-F]{6}$")
+
+var mergeTypes = []string{string(MergeMerge), string(MergeRebase), string(MergeSquash)}
+
+var pluginEventTypes = []string{string(PluginEventPush), string(PluginEventPullRequest), string(PluginEventComment)}
+
+func validateTemplate(path *field.Path, text string) field.ErrorList {
+	if _, err := template.New(path.String()).Parse(text); err != nil {
+		return field.ErrorList{field.Invalid(path, text, err.Error())}
+	}
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
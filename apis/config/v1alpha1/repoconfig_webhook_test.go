@@ -0,0 +1,175 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// invalidFields returns the sorted paths of the invalid fields reported by a validation error
+func invalidFields(t *testing.T, err error) []string {
+	if err == nil {
+		return nil
+	}
+	statusErr, ok := err.(*apierrors.StatusError)
+	if !ok || !apierrors.IsInvalid(err) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	var fields []string
+	for _, cause := range statusErr.ErrStatus.Details.Causes {
+		fields = append(fields, cause.Field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func TestRepoConfigValidate(t *testing.T) {
+	newRepoConfig := func(mutate func(*RepoConfigSpec)) *RepoConfig {
+		r := &RepoConfig{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "repo"},
+			Spec: RepoConfigSpec{
+				GitHub: &GitHubRepo{Owner: "owner", Repo: "repo", Token: Secret{Value: "token"}},
+			},
+		}
+		mutate(&r.Spec)
+		return r
+	}
+	tests := []struct {
+		name   string
+		mutate func(*RepoConfigSpec)
+		want   []string
+	}{{
+		name:   "valid",
+		mutate: func(*RepoConfigSpec) {},
+	}, {
+		name:   "no git server",
+		mutate: func(s *RepoConfigSpec) { s.GitHub = nil },
+		want:   []string{"spec"},
+	}, {
+		name:   "several git servers",
+		mutate: func(s *RepoConfigSpec) { s.Gitea = &GiteaRepo{Owner: "owner", Repo: "repo"} },
+		want:   []string{"spec.gitea"},
+	}, {
+		name:   "missing credentials",
+		mutate: func(s *RepoConfigSpec) { s.GitHub.Token = Secret{} },
+		want:   []string{"spec.gitHub"},
+	}, {
+		name: "credentials inherited from the org config",
+		mutate: func(s *RepoConfigSpec) {
+			s.GitHub.Token = Secret{}
+			s.OrgConfig = "org"
+		},
+	}, {
+		name: "invalid merge types",
+		mutate: func(s *RepoConfigSpec) {
+			s.AutoMerge = &AutoMerge{MergeType: "fast-forward", BranchMergeTypes: map[string]PullRequestMergeType{"release": "octopus"}, BatchSizeLimit: -2}
+		},
+		want: []string{"spec.autoMerge.batchSizeLimit", "spec.autoMerge.branchMergeTypes[release]", "spec.autoMerge.mergeType"},
+	}, {
+		name: "invalid templates",
+		mutate: func(s *RepoConfigSpec) {
+			s.AutoMerge = &AutoMerge{
+				MergeType:           MergeSquash,
+				MergeCommitTemplate: &MergeCommitTemplate{Title: "{{ .Title", Body: "{{ .Body }}"},
+				NeedsRebase:         &NeedsRebase{CommentTemplate: "{{ end }}"},
+			}
+		},
+		want: []string{"spec.autoMerge.mergeCommitTemplate.title", "spec.autoMerge.needsRebase.commentTemplate"},
+	}, {
+		name: "update branch method unsupported by the git server",
+		mutate: func(s *RepoConfigSpec) {
+			s.AutoMerge = &AutoMerge{MergeType: MergeMerge, UpdateBranch: &UpdateBranch{Method: UpdateBranchRebase}}
+		},
+		want: []string{"spec.autoMerge.updateBranch.method"},
+	}, {
+		name: "update branch and needs rebase on bitbucket server",
+		mutate: func(s *RepoConfigSpec) {
+			s.GitHub = nil
+			s.BitbucketServer = &BitbucketServerRepo{Project: "project", Repo: "repo", ServerURL: "https://bitbucket.example.com"}
+			s.AutoMerge = &AutoMerge{MergeType: MergeMerge, UpdateBranch: &UpdateBranch{Method: UpdateBranchMerge}, NeedsRebase: &NeedsRebase{}}
+		},
+		want: []string{"spec.autoMerge.needsRebase", "spec.autoMerge.updateBranch"},
+	}, {
+		name: "negative history",
+		mutate: func(s *RepoConfigSpec) {
+			s.AutoMerge = &AutoMerge{MergeType: MergeMerge, History: &MergeHistory{MaxRecords: -1}}
+		},
+		want: []string{"spec.autoMerge.history.maxRecords"},
+	}, {
+		name: "invalid branch protection",
+		mutate: func(s *RepoConfigSpec) {
+			s.BranchProtection = []BranchProtection{{Branch: "master"}, {Branch: "master", RequiredApprovals: -1}, {}}
+		},
+		want: []string{"spec.branchProtection[1].branch", "spec.branchProtection[1].requiredApprovals", "spec.branchProtection[2].branch"},
+	}, {
+		name: "enforce admins on gitea",
+		mutate: func(s *RepoConfigSpec) {
+			s.GitHub = nil
+			s.Gitea = &GiteaRepo{Owner: "owner", Repo: "repo", Token: Secret{Value: "token"}}
+			s.BranchProtection = []BranchProtection{{Branch: "master", EnforceAdmins: true}}
+		},
+		want: []string{"spec.branchProtection[0].enforceAdmins"},
+	}, {
+		name: "invalid labels",
+		mutate: func(s *RepoConfigSpec) {
+			s.LabelSync = &LabelSync{Labels: []LabelDefinition{
+				{Name: "bug", Color: "ff0000"},
+				{Name: "Bug", Color: "#ff0000"},
+				{Name: "kind/bug", Color: "00ff00", PreviousNames: []string{"BUG"}},
+				{Color: "0000ff"},
+			}}
+		},
+		want: []string{"spec.labelSync.labels[1].color", "spec.labelSync.labels[1].name", "spec.labelSync.labels[2].previousNames[0]", "spec.labelSync.labels[3].name"},
+	}, {
+		name: "invalid plugin config refs",
+		mutate: func(s *RepoConfigSpec) {
+			s.PluginConfig = RepoPluginConfig{
+				Kind: PluginConfigKind,
+				Refs: []PluginConfigRef{{Name: "shared", Kind: ClusterPluginConfigKind, Namespace: "other"}, {Kind: "ConfigMap"}},
+			}
+		},
+		want: []string{"spec.pluginConfig.kind", "spec.pluginConfig.refs[0].namespace", "spec.pluginConfig.refs[1].kind", "spec.pluginConfig.refs[1].name"},
+	}, {
+		name: "plugin enabled and disabled",
+		mutate: func(s *RepoConfigSpec) {
+			s.PluginConfig = RepoPluginConfig{Plugins: []string{"cat", "cat"}, DisabledPlugins: []string{"cat"}}
+		},
+		want: []string{"spec.pluginConfig.disabledPlugins[0]", "spec.pluginConfig.plugins[1]"},
+	}, {
+		name: "invalid config updater",
+		mutate: func(s *RepoConfigSpec) {
+			s.ConfigUpdater = &ConfigUpdater{Paths: []string{"config", "../secrets"}}
+		},
+		want: []string{"spec.configUpdater.paths[1]", "spec.configUpdater.serviceAccountName"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newRepoConfig(tt.mutate)
+			if got := invalidFields(t, r.ValidateCreate()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateCreate() invalid fields = %v, want %v", got, tt.want)
+			}
+			if got := invalidFields(t, r.ValidateUpdate(newRepoConfig(func(*RepoConfigSpec) {}))); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateUpdate() invalid fields = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
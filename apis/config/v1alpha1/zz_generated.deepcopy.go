@@ -21,116 +21,727 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AutoMerge) DeepCopyInto(out *AutoMerge) {
 	*out = *in
+	if in.BranchMergeTypes != nil {
+		in, out := &in.BranchMergeTypes, &out.BranchMergeTypes
+		*out = make(map[string]PullRequestMergeType, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MergeCommitTemplate != nil {
+		in, out := &in.MergeCommitTemplate, &out.MergeCommitTemplate
+		*out = new(MergeCommitTemplate)
+		**out = **in
+	}
 	if in.Labels != nil {
 		in, out := &in.Labels, &out.Labels
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.MissingLabels != nil {
-		in, out := &in.MissingLabels, &out.MissingLabels
+	if in.MissingLabels != nil {
+		in, out := &in.MissingLabels, &out.MissingLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PriorityLabels != nil {
+		in, out := &in.PriorityLabels, &out.PriorityLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UpdateBranch != nil {
+		in, out := &in.UpdateBranch, &out.UpdateBranch
+		*out = new(UpdateBranch)
+		**out = **in
+	}
+	if in.NeedsRebase != nil {
+		in, out := &in.NeedsRebase, &out.NeedsRebase
+		*out = new(NeedsRebase)
+		**out = **in
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = new(MergeHistory)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoMerge.
+func (in *AutoMerge) DeepCopy() *AutoMerge {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoMerge)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BitbucketServerProject) DeepCopyInto(out *BitbucketServerProject) {
+	*out = *in
+	in.HmacToken.DeepCopyInto(&out.HmacToken)
+	in.Token.DeepCopyInto(&out.Token)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BitbucketServerProject.
+func (in *BitbucketServerProject) DeepCopy() *BitbucketServerProject {
+	if in == nil {
+		return nil
+	}
+	out := new(BitbucketServerProject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BitbucketServerRepo) DeepCopyInto(out *BitbucketServerRepo) {
+	*out = *in
+	in.HmacToken.DeepCopyInto(&out.HmacToken)
+	in.Token.DeepCopyInto(&out.Token)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BitbucketServerRepo.
+func (in *BitbucketServerRepo) DeepCopy() *BitbucketServerRepo {
+	if in == nil {
+		return nil
+	}
+	out := new(BitbucketServerRepo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchProtection) DeepCopyInto(out *BranchProtection) {
+	*out = *in
+	if in.RequiredStatusContexts != nil {
+		in, out := &in.RequiredStatusContexts, &out.RequiredStatusContexts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PushTeams != nil {
+		in, out := &in.PushTeams, &out.PushTeams
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchProtection.
+func (in *BranchProtection) DeepCopy() *BranchProtection {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchProtection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchProtectionStatus) DeepCopyInto(out *BranchProtectionStatus) {
+	*out = *in
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchProtectionStatus.
+func (in *BranchProtectionStatus) DeepCopy() *BranchProtectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchProtectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Cat) DeepCopyInto(out *Cat) {
+	*out = *in
+	in.Key.DeepCopyInto(&out.Key)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cat.
+func (in *Cat) DeepCopy() *Cat {
+	if in == nil {
+		return nil
+	}
+	out := new(Cat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterPluginConfig) DeepCopyInto(out *ClusterPluginConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPluginConfig.
+func (in *ClusterPluginConfig) DeepCopy() *ClusterPluginConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterPluginConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterPluginConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterPluginConfigList) DeepCopyInto(out *ClusterPluginConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterPluginConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPluginConfigList.
+func (in *ClusterPluginConfigList) DeepCopy() *ClusterPluginConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterPluginConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterPluginConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Condition) DeepCopyInto(out *Condition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Condition.
+func (in *Condition) DeepCopy() *Condition {
+	if in == nil {
+		return nil
+	}
+	out := new(Condition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigUpdater) DeepCopyInto(out *ConfigUpdater) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigUpdater.
+func (in *ConfigUpdater) DeepCopy() *ConfigUpdater {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigUpdater)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvRef) DeepCopyInto(out *EnvRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvRef.
+func (in *EnvRef) DeepCopy() *EnvRef {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalRef) DeepCopyInto(out *ExternalRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalRef.
+func (in *ExternalRef) DeepCopy() *ExternalRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileRef) DeepCopyInto(out *FileRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileRef.
+func (in *FileRef) DeepCopy() *FileRef {
+	if in == nil {
+		return nil
+	}
+	out := new(FileRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubApp) DeepCopyInto(out *GitHubApp) {
+	*out = *in
+	in.PrivateKey.DeepCopyInto(&out.PrivateKey)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubApp.
+func (in *GitHubApp) DeepCopy() *GitHubApp {
+	if in == nil {
+		return nil
+	}
+	out := new(GitHubApp)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubOrg) DeepCopyInto(out *GitHubOrg) {
+	*out = *in
+	in.HmacToken.DeepCopyInto(&out.HmacToken)
+	in.Token.DeepCopyInto(&out.Token)
+	if in.App != nil {
+		in, out := &in.App, &out.App
+		*out = new(GitHubApp)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubOrg.
+func (in *GitHubOrg) DeepCopy() *GitHubOrg {
+	if in == nil {
+		return nil
+	}
+	out := new(GitHubOrg)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubRepo) DeepCopyInto(out *GitHubRepo) {
+	*out = *in
+	in.HmacToken.DeepCopyInto(&out.HmacToken)
+	in.Token.DeepCopyInto(&out.Token)
+	if in.App != nil {
+		in, out := &in.App, &out.App
+		*out = new(GitHubApp)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubRepo.
+func (in *GitHubRepo) DeepCopy() *GitHubRepo {
+	if in == nil {
+		return nil
+	}
+	out := new(GitHubRepo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitLabGroup) DeepCopyInto(out *GitLabGroup) {
+	*out = *in
+	in.WebhookToken.DeepCopyInto(&out.WebhookToken)
+	in.Token.DeepCopyInto(&out.Token)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitLabGroup.
+func (in *GitLabGroup) DeepCopy() *GitLabGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(GitLabGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitLabRepo) DeepCopyInto(out *GitLabRepo) {
+	*out = *in
+	in.WebhookToken.DeepCopyInto(&out.WebhookToken)
+	in.Token.DeepCopyInto(&out.Token)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitLabRepo.
+func (in *GitLabRepo) DeepCopy() *GitLabRepo {
+	if in == nil {
+		return nil
+	}
+	out := new(GitLabRepo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GiteaOrg) DeepCopyInto(out *GiteaOrg) {
+	*out = *in
+	in.HmacToken.DeepCopyInto(&out.HmacToken)
+	in.Token.DeepCopyInto(&out.Token)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GiteaOrg.
+func (in *GiteaOrg) DeepCopy() *GiteaOrg {
+	if in == nil {
+		return nil
+	}
+	out := new(GiteaOrg)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GiteaRepo) DeepCopyInto(out *GiteaRepo) {
+	*out = *in
+	in.HmacToken.DeepCopyInto(&out.HmacToken)
+	in.Token.DeepCopyInto(&out.Token)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GiteaRepo.
+func (in *GiteaRepo) DeepCopy() *GiteaRepo {
+	if in == nil {
+		return nil
+	}
+	out := new(GiteaRepo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Goose) DeepCopyInto(out *Goose) {
+	*out = *in
+	in.Key.DeepCopyInto(&out.Key)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Goose.
+func (in *Goose) DeepCopy() *Goose {
+	if in == nil {
+		return nil
+	}
+	out := new(Goose)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Label) DeepCopyInto(out *Label) {
+	*out = *in
+	if in.Prefixes != nil {
+		in, out := &in.Prefixes, &out.Prefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdditionalLabels != nil {
+		in, out := &in.AdditionalLabels, &out.AdditionalLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Label.
+func (in *Label) DeepCopy() *Label {
+	if in == nil {
+		return nil
+	}
+	out := new(Label)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelChange) DeepCopyInto(out *LabelChange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelChange.
+func (in *LabelChange) DeepCopy() *LabelChange {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelDefinition) DeepCopyInto(out *LabelDefinition) {
+	*out = *in
+	if in.PreviousNames != nil {
+		in, out := &in.PreviousNames, &out.PreviousNames
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoMerge.
-func (in *AutoMerge) DeepCopy() *AutoMerge {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelDefinition.
+func (in *LabelDefinition) DeepCopy() *LabelDefinition {
 	if in == nil {
 		return nil
 	}
-	out := new(AutoMerge)
+	out := new(LabelDefinition)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Cat) DeepCopyInto(out *Cat) {
+func (in *LabelSync) DeepCopyInto(out *LabelSync) {
 	*out = *in
-	in.Key.DeepCopyInto(&out.Key)
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]LabelDefinition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cat.
-func (in *Cat) DeepCopy() *Cat {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelSync.
+func (in *LabelSync) DeepCopy() *LabelSync {
 	if in == nil {
 		return nil
 	}
-	out := new(Cat)
+	out := new(LabelSync)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitHubRepo) DeepCopyInto(out *GitHubRepo) {
+func (in *MergeCommitTemplate) DeepCopyInto(out *MergeCommitTemplate) {
 	*out = *in
-	in.HmacToken.DeepCopyInto(&out.HmacToken)
-	in.Token.DeepCopyInto(&out.Token)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubRepo.
-func (in *GitHubRepo) DeepCopy() *GitHubRepo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MergeCommitTemplate.
+func (in *MergeCommitTemplate) DeepCopy() *MergeCommitTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(GitHubRepo)
+	out := new(MergeCommitTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GiteaRepo) DeepCopyInto(out *GiteaRepo) {
+func (in *MergeHistory) DeepCopyInto(out *MergeHistory) {
 	*out = *in
-	in.HmacToken.DeepCopyInto(&out.HmacToken)
-	in.Token.DeepCopyInto(&out.Token)
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GiteaRepo.
-func (in *GiteaRepo) DeepCopy() *GiteaRepo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MergeHistory.
+func (in *MergeHistory) DeepCopy() *MergeHistory {
 	if in == nil {
 		return nil
 	}
-	out := new(GiteaRepo)
+	out := new(MergeHistory)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Goose) DeepCopyInto(out *Goose) {
+func (in *NeedsRebase) DeepCopyInto(out *NeedsRebase) {
 	*out = *in
-	in.Key.DeepCopyInto(&out.Key)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Goose.
-func (in *Goose) DeepCopy() *Goose {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NeedsRebase.
+func (in *NeedsRebase) DeepCopy() *NeedsRebase {
 	if in == nil {
 		return nil
 	}
-	out := new(Goose)
+	out := new(NeedsRebase)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Label) DeepCopyInto(out *Label) {
+func (in *OrgConfig) DeepCopyInto(out *OrgConfig) {
 	*out = *in
-	if in.AdditionalLabels != nil {
-		in, out := &in.AdditionalLabels, &out.AdditionalLabels
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgConfig.
+func (in *OrgConfig) DeepCopy() *OrgConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrgConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgConfigList) DeepCopyInto(out *OrgConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrgConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgConfigList.
+func (in *OrgConfigList) DeepCopy() *OrgConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrgConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgConfigSpec) DeepCopyInto(out *OrgConfigSpec) {
+	*out = *in
+	if in.GitHub != nil {
+		in, out := &in.GitHub, &out.GitHub
+		*out = new(GitHubOrg)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Gitea != nil {
+		in, out := &in.Gitea, &out.Gitea
+		*out = new(GiteaOrg)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GitLab != nil {
+		in, out := &in.GitLab, &out.GitLab
+		*out = new(GitLabGroup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BitbucketServer != nil {
+		in, out := &in.BitbucketServer, &out.BitbucketServer
+		*out = new(BitbucketServerProject)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AutoMerge != nil {
+		in, out := &in.AutoMerge, &out.AutoMerge
+		*out = new(AutoMerge)
+		(*in).DeepCopyInto(*out)
+	}
+	in.PluginConfig.DeepCopyInto(&out.PluginConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgConfigSpec.
+func (in *OrgConfigSpec) DeepCopy() *OrgConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgConfigStatus) DeepCopyInto(out *OrgConfigStatus) {
+	*out = *in
+	if in.Repositories != nil {
+		in, out := &in.Repositories, &out.Repositories
+		*out = make([]OrgRepoStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Label.
-func (in *Label) DeepCopy() *Label {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgConfigStatus.
+func (in *OrgConfigStatus) DeepCopy() *OrgConfigStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Label)
+	out := new(OrgConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgRepoStatus) DeepCopyInto(out *OrgRepoStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgRepoStatus.
+func (in *OrgRepoStatus) DeepCopy() *OrgRepoStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgRepoStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -171,7 +782,7 @@ func (in *PluginConfig) DeepCopyInto(out *PluginConfig) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginConfig.
@@ -192,6 +803,21 @@ func (in *PluginConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginConfigConsumer) DeepCopyInto(out *PluginConfigConsumer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginConfigConsumer.
+func (in *PluginConfigConsumer) DeepCopy() *PluginConfigConsumer {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginConfigConsumer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PluginConfigList) DeepCopyInto(out *PluginConfigList) {
 	*out = *in
@@ -224,6 +850,21 @@ func (in *PluginConfigList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginConfigRef) DeepCopyInto(out *PluginConfigRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginConfigRef.
+func (in *PluginConfigRef) DeepCopy() *PluginConfigRef {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginConfigRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PluginConfigSpec) DeepCopyInto(out *PluginConfigSpec) {
 	*out = *in
@@ -232,7 +873,7 @@ func (in *PluginConfigSpec) DeepCopyInto(out *PluginConfigSpec) {
 	in.Goose.DeepCopyInto(&out.Goose)
 	in.Label.DeepCopyInto(&out.Label)
 	out.Size = in.Size
-	out.Welcome = in.Welcome
+	in.Welcome.DeepCopyInto(&out.Welcome)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginConfigSpec.
@@ -248,6 +889,18 @@ func (in *PluginConfigSpec) DeepCopy() *PluginConfigSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PluginConfigStatus) DeepCopyInto(out *PluginConfigStatus) {
 	*out = *in
+	if in.Consumers != nil {
+		in, out := &in.Consumers, &out.Consumers
+		*out = make([]PluginConfigConsumer, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginConfigStatus.
@@ -260,13 +913,33 @@ func (in *PluginConfigStatus) DeepCopy() *PluginConfigStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginEvents) DeepCopyInto(out *PluginEvents) {
+	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]PluginEventType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginEvents.
+func (in *PluginEvents) DeepCopy() *PluginEvents {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginEvents)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RepoConfig) DeepCopyInto(out *RepoConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepoConfig.
@@ -332,12 +1005,39 @@ func (in *RepoConfigSpec) DeepCopyInto(out *RepoConfigSpec) {
 		*out = new(GiteaRepo)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GitLab != nil {
+		in, out := &in.GitLab, &out.GitLab
+		*out = new(GitLabRepo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BitbucketServer != nil {
+		in, out := &in.BitbucketServer, &out.BitbucketServer
+		*out = new(BitbucketServerRepo)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AutoMerge != nil {
 		in, out := &in.AutoMerge, &out.AutoMerge
 		*out = new(AutoMerge)
 		(*in).DeepCopyInto(*out)
 	}
 	in.PluginConfig.DeepCopyInto(&out.PluginConfig)
+	if in.BranchProtection != nil {
+		in, out := &in.BranchProtection, &out.BranchProtection
+		*out = make([]BranchProtection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LabelSync != nil {
+		in, out := &in.LabelSync, &out.LabelSync
+		*out = new(LabelSync)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigUpdater != nil {
+		in, out := &in.ConfigUpdater, &out.ConfigUpdater
+		*out = new(ConfigUpdater)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepoConfigSpec.
@@ -353,6 +1053,35 @@ func (in *RepoConfigSpec) DeepCopy() *RepoConfigSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RepoConfigStatus) DeepCopyInto(out *RepoConfigStatus) {
 	*out = *in
+	if in.BranchProtection != nil {
+		in, out := &in.BranchProtection, &out.BranchProtection
+		*out = make([]BranchProtectionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LabelChanges != nil {
+		in, out := &in.LabelChanges, &out.LabelChanges
+		*out = make([]LabelChange, len(*in))
+		copy(*out, *in)
+	}
+	if in.PluginConfig != nil {
+		in, out := &in.PluginConfig, &out.PluginConfig
+		*out = new(PluginConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepoConfigStatus.
@@ -368,6 +1097,11 @@ func (in *RepoConfigStatus) DeepCopy() *RepoConfigStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RepoPluginConfig) DeepCopyInto(out *RepoPluginConfig) {
 	*out = *in
+	if in.Refs != nil {
+		in, out := &in.Refs, &out.Refs
+		*out = make([]PluginConfigRef, len(*in))
+		copy(*out, *in)
+	}
 	if in.Spec != nil {
 		in, out := &in.Spec, &out.Spec
 		*out = new(PluginConfigSpec)
@@ -378,6 +1112,18 @@ func (in *RepoPluginConfig) DeepCopyInto(out *RepoPluginConfig) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DisabledPlugins != nil {
+		in, out := &in.DisabledPlugins, &out.DisabledPlugins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PluginEvents != nil {
+		in, out := &in.PluginEvents, &out.PluginEvents
+		*out = make([]PluginEvents, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepoPluginConfig.
@@ -425,10 +1171,44 @@ func (in *Size) DeepCopy() *Size {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpdateBranch) DeepCopyInto(out *UpdateBranch) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateBranch.
+func (in *UpdateBranch) DeepCopy() *UpdateBranch {
+	if in == nil {
+		return nil
+	}
+	out := new(UpdateBranch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ValueFrom) DeepCopyInto(out *ValueFrom) {
 	*out = *in
-	in.SecretKeyRef.DeepCopyInto(&out.SecretKeyRef)
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FileRef != nil {
+		in, out := &in.FileRef, &out.FileRef
+		*out = new(FileRef)
+		**out = **in
+	}
+	if in.EnvRef != nil {
+		in, out := &in.EnvRef, &out.EnvRef
+		*out = new(EnvRef)
+		**out = **in
+	}
+	if in.ExternalRef != nil {
+		in, out := &in.ExternalRef, &out.ExternalRef
+		*out = new(ExternalRef)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValueFrom.
@@ -444,6 +1224,11 @@ func (in *ValueFrom) DeepCopy() *ValueFrom {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Welcome) DeepCopyInto(out *Welcome) {
 	*out = *in
+	if in.MessageTemplate != nil {
+		in, out := &in.MessageTemplate, &out.MessageTemplate
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Welcome.
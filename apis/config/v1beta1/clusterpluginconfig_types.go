@@ -0,0 +1,53 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name=ready,JSONPath=.status.conditions[?(@.type=="Ready")].status,type=string
+// +kubebuilder:printcolumn:name=age,JSONPath=.metadata.creationTimestamp,type=date
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// ClusterPluginConfig is the Schema for the clusterpluginconfigs API.
+// It holds a plugin config shared by the repo configs of all namespaces.
+type ClusterPluginConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PluginConfigSpec   `json:"spec,omitempty"`
+	Status PluginConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterPluginConfigList contains a list of ClusterPluginConfig
+type ClusterPluginConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterPluginConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterPluginConfig{}, &ClusterPluginConfigList{})
+}
@@ -0,0 +1,159 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GitHubOrg defines a GitHub organization
+type GitHubOrg struct {
+	// Owner is the organization (or user) name
+	Owner string `json:"owner"`
+	// ServerURL is the GitHub server url, defaults to the public GitHub api
+	ServerURL string `json:"server,omitempty"`
+	// HmacToken is the secret used to validate webhooks
+	HmacToken Secret `json:"hmacToken"`
+	// Token is the token used to interact with the git repositories, mutually exclusive with App
+	Token Secret `json:"token,omitempty"`
+	// App defines the GitHub App used to interact with the git repositories, mutually exclusive with Token
+	App *GitHubApp `json:"app,omitempty"`
+}
+
+// GiteaOrg defines a Gitea organization
+type GiteaOrg struct {
+	// Owner is the organization (or user) name
+	Owner string `json:"owner"`
+	// ServerURL is the Gitea server url
+	ServerURL string `json:"server,omitempty"`
+	// HmacToken is the secret used to validate webhooks
+	HmacToken Secret `json:"hmacToken"`
+	// Token is the token used to interact with the git repositories
+	Token Secret `json:"token"`
+}
+
+// GitLabGroup defines a GitLab group
+type GitLabGroup struct {
+	// Group is the group path (group/subgroup), projects of subgroups are not matched
+	Group string `json:"group"`
+	// ServerURL is the GitLab server url, defaults to the public GitLab
+	ServerURL string `json:"server,omitempty"`
+	// WebhookToken is the secret token sent by GitLab with webhooks
+	WebhookToken Secret `json:"webhookToken"`
+	// Token is the access token used to interact with the projects
+	Token Secret `json:"token"`
+}
+
+// BitbucketServerProject defines a Bitbucket Server project
+type BitbucketServerProject struct {
+	// Project is the project key
+	Project string `json:"project"`
+	// ServerURL is the Bitbucket Server url
+	ServerURL string `json:"server"`
+	// HmacToken is the secret used to validate webhooks
+	HmacToken Secret `json:"hmacToken"`
+	// Token is the http access token used to interact with the repositories
+	Token Secret `json:"token"`
+}
+
+// OrgConfigSpec defines the desired state of OrgConfig
+type OrgConfigSpec struct {
+	// BotName is the bot name used by plugins, defaults to the login of the user owning the token (or the GitHub App bot)
+	BotName string `json:"botName,omitempty"`
+	// GitHub defines the GitHub organization details
+	GitHub *GitHubOrg `json:"gitHub,omitempty"`
+	// Gitea defines the Gitea organization details
+	Gitea *GiteaOrg `json:"gitea,omitempty"`
+	// GitLab defines the GitLab group details
+	GitLab *GitLabGroup `json:"gitLab,omitempty"`
+	// BitbucketServer defines the Bitbucket Server project details
+	BitbucketServer *BitbucketServerProject `json:"bitbucketServer,omitempty"`
+	// Include are the repository name patterns (path.Match syntax) the config applies to, defaults to all repositories
+	Include []string `json:"include,omitempty"`
+	// Exclude are the repository name patterns (path.Match syntax) the config does not apply to, they take precedence over Include
+	Exclude []string `json:"exclude,omitempty"`
+	// AutoMerge is the default auto merge configuration of the repositories
+	AutoMerge *AutoMerge `json:"autoMerge,omitempty"`
+	// PluginConfig is the default plugin configuration of the repositories
+	PluginConfig RepoPluginConfig `json:"pluginConfig"`
+}
+
+// OrgConfig condition types
+const (
+	// OrgConfigReady tells that the org config is fully operational
+	OrgConfigReady ConditionType = "Ready"
+	// OrgConfigTokenValid tells that the token resolves and authenticates
+	OrgConfigTokenValid ConditionType = "TokenValid"
+	// OrgConfigReposListed tells that the repositories of the organization are listed from the git server
+	OrgConfigReposListed ConditionType = "ReposListed"
+	// OrgConfigWebhooksRegistered tells that the webhooks of the repositories configured by the org config only are registered,
+	// it is true with the NotManaged reason when the controller does not register webhooks
+	OrgConfigWebhooksRegistered ConditionType = "WebhooksRegistered"
+)
+
+// OrgRepoStatus defines the observed state of a repository configured by an OrgConfig only
+type OrgRepoStatus struct {
+	// Name is the repository name
+	Name string `json:"name"`
+	// WebhookID is the id of the repository webhook registered by the controller
+	WebhookID int64 `json:"webhookID,omitempty"`
+	// WebhookSecretHash is the sha256 hash of the secret last pushed to the repository webhook,
+	// the webhook is updated when the resolved secret hash changes
+	WebhookSecretHash string `json:"webhookSecretHash,omitempty"`
+	// Error is the last error registering the repository webhook, empty when registered
+	Error string `json:"error,omitempty"`
+}
+
+// OrgConfigStatus defines the observed state of OrgConfig
+type OrgConfigStatus struct {
+	// ObservedGeneration is the last generation reconciled by the controller
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Repositories are the repositories matched by the org config that have no RepoConfig,
+	// repositories with a RepoConfig report their state in the RepoConfig status
+	Repositories []OrgRepoStatus `json:"repositories,omitempty"`
+	// Conditions are the observations of the org config state
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name=ready,JSONPath=.status.conditions[?(@.type=="Ready")].status,type=string
+// +kubebuilder:printcolumn:name=age,JSONPath=.metadata.creationTimestamp,type=date
+// +kubebuilder:subresource:status
+
+// OrgConfig is the Schema for the orgconfigs API
+// It configures the repositories of an organization it matches, RepoConfigs referencing it override its settings for their repository.
+type OrgConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              OrgConfigSpec   `json:"spec,omitempty"`
+	Status            OrgConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrgConfigList contains a list of OrgConfig
+type OrgConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrgConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OrgConfig{}, &OrgConfigList{})
+}
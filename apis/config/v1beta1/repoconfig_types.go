@@ -0,0 +1,402 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AutoMerge defines auto merge configuration
+type AutoMerge struct {
+	// BatchSizeLimitMap is the batch size limit as the value.
+	// Special values:
+	//  0 => unlimited batch size
+	// -1 => batch merging disabled :(
+	BatchSizeLimit int `json:"batchSizeLimit"`
+	// MergeType is the merge method to use when merging pull requests.
+	// Valid options are squash, rebase, and merge.
+	MergeType PullRequestMergeType `json:"mergeType"`
+	// BranchMergeTypes overrides the merge method per target branch name.
+	// Pull requests can still override it with a merge method label (tide/merge-method-<type>).
+	BranchMergeTypes map[string]PullRequestMergeType `json:"branchMergeTypes,omitempty"`
+	// MergeCommitTemplate is the template used to build the commit title and body of squash merges
+	MergeCommitTemplate *MergeCommitTemplate `json:"mergeCommitTemplate,omitempty"`
+	// Labels are the labels required on pull requests for merging
+	Labels []string `json:"labels"`
+	// MissingLabels are the labels that must not be present on pull requests for merging
+	MissingLabels []string `json:"missingLabels"`
+	// ReviewApprovedRequired tells that review must be approved on pull requests for merging
+	ReviewApprovedRequired bool `json:"reviewApprovedRequired"`
+	// PriorityLabels are the labels giving priority to pull requests in the merge queue, most urgent first.
+	// Pull requests are queued (and batched) by priority, then by age.
+	PriorityLabels []string `json:"priorityLabels,omitempty"`
+	// UpdateBranch configures updating pull request branches with their base branch before merging, not supported by Bitbucket Server
+	UpdateBranch *UpdateBranch `json:"updateBranch,omitempty"`
+	// NeedsRebase configures labelling and commenting pull requests that have merge conflicts, not supported by Bitbucket Server
+	NeedsRebase *NeedsRebase `json:"needsRebase,omitempty"`
+	// History configures the retention of merge records
+	History *MergeHistory `json:"history,omitempty"`
+	// DryRun evaluates pull requests and updates status contexts as usual but only logs
+	// the merges that would have been done, without mutating the repository
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// MergeHistory defines the retention limits of merge records
+type MergeHistory struct {
+	// MaxRecords is the maximum number of merge records kept for the repository, 0 means unlimited
+	MaxRecords int `json:"maxRecords,omitempty"`
+	// MaxAge is the maximum age of merge records kept for the repository, unlimited when not set
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+}
+
+// UpdateBranch defines how pull request branches are kept up to date with their base branch
+type UpdateBranch struct {
+	// Method is the method used to update the pull request branch.
+	// Valid options are merge and rebase, GitHub only supports merge and GitLab only supports rebase.
+	Method UpdateBranchMethod `json:"method"`
+}
+
+// NeedsRebase defines how pull requests with merge conflicts are reported
+type NeedsRebase struct {
+	// Label is the label added to pull requests that have merge conflicts, defaults to needs-rebase
+	Label string `json:"label,omitempty"`
+	// CommentTemplate is the comment template posted when the label is added
+	CommentTemplate string `json:"commentTemplate,omitempty"`
+}
+
+// MergeCommitTemplate defines the commit title and body templates used for squash merges.
+// Templates use the go text/template syntax and are evaluated against the pull request being merged.
+type MergeCommitTemplate struct {
+	// Title is the commit title template
+	Title string `json:"title,omitempty"`
+	// Body is the commit body template
+	Body string `json:"body,omitempty"`
+}
+
+// BranchProtection defines the protection rules of a branch
+type BranchProtection struct {
+	// Branch is the protected branch name
+	Branch string `json:"branch"`
+	// RequiredStatusContexts are the status contexts that must succeed before merging
+	RequiredStatusContexts []string `json:"requiredStatusContexts,omitempty"`
+	// RequiredApprovals is the number of approving reviews required before merging
+	RequiredApprovals int `json:"requiredApprovals,omitempty"`
+	// DismissStaleReviews dismisses approvals when new commits are pushed
+	DismissStaleReviews bool `json:"dismissStaleReviews,omitempty"`
+	// PushTeams are the teams allowed to push to the branch, anyone with write access can push when empty
+	PushTeams []string `json:"pushTeams,omitempty"`
+	// EnforceAdmins applies the rules to repository administrators (GitHub only)
+	EnforceAdmins bool `json:"enforceAdmins,omitempty"`
+}
+
+// LabelDefinition defines a repository label
+type LabelDefinition struct {
+	// Name is the label name
+	Name string `json:"name"`
+	// Color is the label hex color code, without the leading #
+	Color string `json:"color"`
+	// Description is the label description
+	Description string `json:"description,omitempty"`
+	// PreviousNames are the names the label had before, existing labels with these names are renamed
+	PreviousNames []string `json:"previousNames,omitempty"`
+}
+
+// LabelSync defines the labels synced to the repository
+type LabelSync struct {
+	// Labels are the label definitions
+	Labels []LabelDefinition `json:"labels"`
+	// DeleteUndefined deletes the repository labels that are not defined
+	DeleteUndefined bool `json:"deleteUndefined,omitempty"`
+	// DryRun reports the changes in the status without applying them
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// DefaultConfigUpdaterBranch is the default branch whose pushes are applied by the config updater
+const DefaultConfigUpdaterBranch = "master"
+
+// ConfigUpdater defines the KLoops config manifests (RepoConfig, OrgConfig, PluginConfig and Job) stored in the repository.
+// Manifests are applied to the namespace of the repo config when pushed to the branch,
+// and the planned changes are commented on the pull requests targeting the branch.
+type ConfigUpdater struct {
+	// Branch is the branch whose pushes are applied, defaults to master
+	Branch string `json:"branch,omitempty"`
+	// Paths are the manifest files or directories, relative to the repository root.
+	// Directories are read recursively, only .yaml, .yml and .json files are read.
+	Paths []string `json:"paths"`
+	// Prune deletes the objects previously applied from the repository that are no longer defined in it
+	Prune bool `json:"prune,omitempty"`
+	// ServiceAccountName is the service account of the repo config namespace impersonated to read and apply the manifests,
+	// the objects are applied with its permissions and go through the admission webhooks as its requests.
+	ServiceAccountName string `json:"serviceAccountName"`
+}
+
+// DefaultGitHubServerURL is the public GitHub api url
+const DefaultGitHubServerURL = "https://api.github.com"
+
+// GitHubRepo defines a GitHub repository
+type GitHubRepo struct {
+	// Owner is the repository owner name
+	Owner string `json:"owner"`
+	// Repo is the repository owner name
+	Repo string `json:"repo"`
+	// ServerURL is the GitHub server url, defaults to the public GitHub api
+	ServerURL string `json:"server,omitempty"`
+	// HmacToken is the secret used to validate webhooks
+	HmacToken Secret `json:"hmacToken"`
+	// Token is the token used to interact with the git repository, mutually exclusive with App
+	Token Secret `json:"token,omitempty"`
+	// App defines the GitHub App used to interact with the git repository, mutually exclusive with Token
+	App *GitHubApp `json:"app,omitempty"`
+}
+
+// GitHubApp defines GitHub App credentials, short lived installation tokens are minted from them
+type GitHubApp struct {
+	// AppID is the GitHub App id
+	AppID int64 `json:"appID"`
+	// InstallationID is the id of the GitHub App installation on the repository owner
+	InstallationID int64 `json:"installationID"`
+	// PrivateKey is the GitHub App PEM encoded private key
+	PrivateKey Secret `json:"privateKey"`
+}
+
+// GiteaRepo defines a Gitea repository
+type GiteaRepo struct {
+	// Owner is the repository owner name
+	Owner string `json:"owner"`
+	// Repo is the repository owner name
+	Repo string `json:"repo"`
+	// ServerURL is the GitHub server url
+	ServerURL string `json:"server,omitempty"`
+	// HmacToken is the secret used to validate webhooks
+	HmacToken Secret `json:"hmacToken"`
+	// Token is the token used to interact with the git repository
+	Token Secret `json:"token"`
+}
+
+// DefaultGitLabServerURL is the public GitLab url
+const DefaultGitLabServerURL = "https://gitlab.com"
+
+// GitLabRepo defines a GitLab project
+type GitLabRepo struct {
+	// Project is the project path, including its groups (group/subgroup/project)
+	Project string `json:"project"`
+	// ServerURL is the GitLab server url, defaults to the public GitLab
+	ServerURL string `json:"server,omitempty"`
+	// WebhookToken is the secret token sent by GitLab with webhooks
+	WebhookToken Secret `json:"webhookToken"`
+	// Token is the access token used to interact with the project
+	Token Secret `json:"token"`
+}
+
+// BitbucketServerRepo defines a Bitbucket Server repository
+type BitbucketServerRepo struct {
+	// Project is the project key
+	Project string `json:"project"`
+	// Repo is the repository slug
+	Repo string `json:"repo"`
+	// ServerURL is the Bitbucket Server url
+	ServerURL string `json:"server"`
+	// HmacToken is the secret used to validate webhooks
+	HmacToken Secret `json:"hmacToken"`
+	// Token is the http access token used to interact with the repository
+	Token Secret `json:"token"`
+}
+
+// Kinds a RepoPluginConfig can reference
+const (
+	PluginConfigKind        = "PluginConfig"
+	ClusterPluginConfigKind = "ClusterPluginConfig"
+)
+
+// PluginConfigRef defines a reference to a PluginConfig or ClusterPluginConfig
+type PluginConfigRef struct {
+	// Name is the name of the referenced config
+	Name string `json:"name"`
+	// Kind is the kind of the referenced config, PluginConfig (default) or ClusterPluginConfig
+	// +kubebuilder:validation:Enum=PluginConfig;ClusterPluginConfig
+	Kind string `json:"kind,omitempty"`
+	// Namespace is the namespace of the referenced PluginConfig, defaults to the namespace of the referencing object
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// RepoPluginConfig defines a PluginConfig, layered from the referenced configs and the inline spec.
+// The referenced configs are merged in order (ref first, then refs) and the inline spec is merged on top:
+// scalar fields and secrets override when set, lists replace when not empty.
+type RepoPluginConfig struct {
+	// Ref is the name of the referenced PluginConfig or ClusterPluginConfig
+	Ref string `json:"ref,omitempty"`
+	// Kind is the kind of the referenced config, PluginConfig (default) or ClusterPluginConfig
+	// +kubebuilder:validation:Enum=PluginConfig;ClusterPluginConfig
+	Kind string `json:"kind,omitempty"`
+	// Namespace is the namespace of the referenced PluginConfig, defaults to the namespace of the referencing object
+	Namespace string `json:"namespace,omitempty"`
+	// Refs are additional referenced configs, merged in order after ref
+	Refs []PluginConfigRef `json:"refs,omitempty"`
+	Spec *PluginConfigSpec `json:"spec,omitempty"`
+	// Plugins are the enabled plugins, added to the inherited default set
+	Plugins []string `json:"plugins,omitempty"`
+	// DisabledPlugins are the plugins removed from the inherited default set
+	DisabledPlugins []string `json:"disabledPlugins,omitempty"`
+	// PluginEvents restricts plugins to event types, plugins handle all their supported events when not set
+	PluginEvents []PluginEvents `json:"pluginEvents,omitempty"`
+}
+
+// PluginEventType is the type of event a plugin handles
+// +kubebuilder:validation:Enum=push;pull_request;comment
+type PluginEventType string
+
+// Plugin event types, matching the git server webhook kinds
+const (
+	PluginEventPush        PluginEventType = "push"
+	PluginEventPullRequest PluginEventType = "pull_request"
+	PluginEventComment     PluginEventType = "comment"
+)
+
+// PluginEvents defines the event types a plugin is restricted to
+type PluginEvents struct {
+	// Plugin is the plugin name
+	Plugin string `json:"plugin"`
+	// Events are the event types the plugin handles
+	// +kubebuilder:validation:MinItems=1
+	Events []PluginEventType `json:"events"`
+}
+
+// RepoConfigSpec defines the desired state of RepoConfig
+type RepoConfigSpec struct {
+	// BotName is the bot name used by plugins, defaults to the login of the user owning the token (or the GitHub App bot)
+	BotName string `json:"botName,omitempty"`
+	// GitHub defines the GitHub repository details
+	GitHub *GitHubRepo `json:"gitHub,omitempty"`
+	// Gitea defines the Gitea repository details
+	Gitea *GiteaRepo `json:"gitea,omitempty"`
+	// GitLab defines the GitLab project details
+	GitLab *GitLabRepo `json:"gitLab,omitempty"`
+	// BitbucketServer defines the Bitbucket Server repository details
+	BitbucketServer *BitbucketServerRepo `json:"bitbucketServer,omitempty"`
+	// AutoMerge configuration for the repository
+	AutoMerge *AutoMerge `json:"autoMerge,omitempty"`
+	// PluginConfig defines the plugin configuration for the repository
+	PluginConfig RepoPluginConfig `json:"pluginConfig"`
+	// BranchProtection defines the branch protection rules synced to the git server
+	BranchProtection []BranchProtection `json:"branchProtection,omitempty"`
+	// LabelSync defines the labels synced to the git server
+	LabelSync *LabelSync `json:"labelSync,omitempty"`
+	// ConfigUpdater applies the KLoops config manifests stored in the repository
+	ConfigUpdater *ConfigUpdater `json:"configUpdater,omitempty"`
+	// OrgConfig is the name of the OrgConfig this repo config overrides.
+	// When set, settings (including credentials) left empty are inherited from the OrgConfig.
+	OrgConfig string `json:"orgConfig,omitempty"`
+}
+
+// RepoConfig condition types
+const (
+	// RepoConfigReady tells that the repo config is fully operational
+	RepoConfigReady ConditionType = "Ready"
+	// RepoConfigTokenValid tells that the token resolves, authenticates and gives write access to the repository
+	RepoConfigTokenValid ConditionType = "TokenValid"
+	// RepoConfigWebhookRegistered tells that the repository webhook is registered on the git server,
+	// it is true with the NotManaged reason when the controller does not register webhooks
+	RepoConfigWebhookRegistered ConditionType = "WebhookRegistered"
+	// RepoConfigPluginConfigResolved tells that the referenced plugin config exists
+	RepoConfigPluginConfigResolved ConditionType = "PluginConfigResolved"
+	// RepoConfigOrgConfigResolved tells that the referenced org config exists
+	RepoConfigOrgConfigResolved ConditionType = "OrgConfigResolved"
+	// RepoConfigBranchProtectionSynced tells that the branch protection rules are synced to the git server
+	RepoConfigBranchProtectionSynced ConditionType = "BranchProtectionSynced"
+	// RepoConfigLabelsSynced tells that the label definitions are synced to the git server
+	RepoConfigLabelsSynced ConditionType = "LabelsSynced"
+)
+
+// RepoConfigStatus defines the observed state of RepoConfig
+type RepoConfigStatus struct {
+	// ObservedGeneration is the last generation reconciled by the controller
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// WebhookID is the id of the repository webhook registered by the controller
+	WebhookID int64 `json:"webhookID,omitempty"`
+	// WebhookSecretHash is the sha256 hash of the secret last pushed to the repository webhook,
+	// the webhook is updated when the resolved secret hash changes
+	WebhookSecretHash string `json:"webhookSecretHash,omitempty"`
+	// BranchProtection is the state of the branch protection rules on the git server
+	BranchProtection []BranchProtectionStatus `json:"branchProtection,omitempty"`
+	// LabelChanges are the label changes applied when last synced, or planned in dry-run mode
+	LabelChanges []LabelChange `json:"labelChanges,omitempty"`
+	// PluginConfig is the effective plugin config, merged from the referenced configs and the inline spec
+	PluginConfig *PluginConfigSpec `json:"pluginConfig,omitempty"`
+	// Plugins are the effective enabled plugins
+	Plugins []string `json:"plugins,omitempty"`
+	// Conditions are the observations of the repo config state
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// LabelAction is the action taken on a label when syncing
+type LabelAction string
+
+// Label actions
+const (
+	LabelCreate LabelAction = "create"
+	LabelUpdate LabelAction = "update"
+	LabelRename LabelAction = "rename"
+	LabelDelete LabelAction = "delete"
+)
+
+// LabelChange defines a change made to a repository label
+type LabelChange struct {
+	// Action is the action taken on the label
+	Action LabelAction `json:"action"`
+	// Label is the label name
+	Label string `json:"label"`
+	// PreviousName is the label name before it was renamed
+	PreviousName string `json:"previousName,omitempty"`
+}
+
+// BranchProtectionStatus defines the observed state of a branch protection
+type BranchProtectionStatus struct {
+	// Branch is the protected branch name
+	Branch string `json:"branch"`
+	// Drift are the rules that differed from the desired state on the git server when last synced
+	Drift []string `json:"drift,omitempty"`
+	// Error is the error that occurred when last syncing the rules
+	Error string `json:"error,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name=ready,JSONPath=.status.conditions[?(@.type=="Ready")].status,type=string
+// +kubebuilder:printcolumn:name=age,JSONPath=.metadata.creationTimestamp,type=date
+// +kubebuilder:subresource:status
+
+// RepoConfig is the Schema for the repoconfigs API
+type RepoConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              RepoConfigSpec   `json:"spec,omitempty"`
+	Status            RepoConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RepoConfigList contains a list of RepoConfig
+type RepoConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RepoConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RepoConfig{}, &RepoConfigList{})
+}
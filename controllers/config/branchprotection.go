@@ -0,0 +1,151 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	configv1alpha1 "github.com/kloops-io/kloops/apis/config/v1alpha1"
+	"github.com/kloops-io/kloops/pkg/scm"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// syncBranchProtection applies the branch protection rules of the repo config on the git server
+// and records the rules that drifted from the desired state in the status
+func (r *RepoConfigReconciler) syncBranchProtection(ctx context.Context, log logr.Logger, repoConfig *configv1alpha1.RepoConfig, scmClient scm.Client, status *configv1alpha1.RepoConfigStatus) configv1alpha1.Condition {
+	status.BranchProtection = nil
+	if len(repoConfig.Spec.BranchProtection) == 0 {
+		return newCondition(configv1alpha1.RepoConfigBranchProtectionSynced, corev1.ConditionTrue, "NoBranchProtection", "no branch protection rules")
+	}
+	if scmClient == nil {
+		return newCondition(configv1alpha1.RepoConfigBranchProtectionSynced, corev1.ConditionUnknown, "TokenInvalid", "branch protection rules cannot be synced without a valid token")
+	}
+	var failed, unsupported, drifted, protected []string
+	for _, protection := range repoConfig.Spec.BranchProtection {
+		branchStatus := configv1alpha1.BranchProtectionStatus{Branch: protection.Branch}
+		created, drift, err := syncBranch(ctx, scmClient, protection, func(created bool, drift []string) {
+			if created {
+				log.Info("branch is not protected, creating the protection rules", "branch", protection.Branch)
+				if r.Recorder != nil {
+					r.Recorder.Eventf(repoConfig, corev1.EventTypeNormal, "BranchProtectionCreated", "branch %s is not protected on the git server, creating its protection rules", protection.Branch)
+				}
+				return
+			}
+			log.Info("branch protection drift detected, overwriting", "branch", protection.Branch, "drift", drift)
+			if r.Recorder != nil {
+				r.Recorder.Eventf(repoConfig, corev1.EventTypeWarning, "BranchProtectionDrift", "branch %s protection rules %s drifted on the git server, overwriting them", protection.Branch, strings.Join(drift, ", "))
+			}
+		})
+		switch {
+		case errors.Is(err, scm.ErrUnsupported):
+			branchStatus.Error = err.Error()
+			unsupported = append(unsupported, protection.Branch)
+		case err != nil:
+			branchStatus.Error = err.Error()
+			failed = append(failed, protection.Branch)
+		}
+		if created && err == nil {
+			protected = append(protected, protection.Branch)
+		}
+		if len(drift) != 0 {
+			branchStatus.Drift = drift
+			drifted = append(drifted, protection.Branch)
+		}
+		status.BranchProtection = append(status.BranchProtection, branchStatus)
+	}
+	switch {
+	case len(failed) != 0:
+		return newCondition(configv1alpha1.RepoConfigBranchProtectionSynced, corev1.ConditionFalse, "SyncFailed", fmt.Sprintf("failed to sync branches %s", strings.Join(append(failed, unsupported...), ", ")))
+	case len(unsupported) != 0:
+		return newCondition(configv1alpha1.RepoConfigBranchProtectionSynced, corev1.ConditionFalse, "Unsupported", fmt.Sprintf("branch protection rules of branches %s are not supported by the git server", strings.Join(unsupported, ", ")))
+	case len(drifted) != 0:
+		return newCondition(configv1alpha1.RepoConfigBranchProtectionSynced, corev1.ConditionTrue, "DriftCorrected", fmt.Sprintf("drift corrected on branches %s", strings.Join(drifted, ", ")))
+	case len(protected) != 0:
+		return newCondition(configv1alpha1.RepoConfigBranchProtectionSynced, corev1.ConditionTrue, "Created", fmt.Sprintf("branch protection rules created on branches %s", strings.Join(protected, ", ")))
+	}
+	return newCondition(configv1alpha1.RepoConfigBranchProtectionSynced, corev1.ConditionTrue, "Synced", "branch protection rules are in sync")
+}
+
+// syncBranch creates the branch protection when the branch is not protected, or updates it when it differs from the desired state.
+// It returns whether the protection was created and the rules that differed.
+// onChange is called before the git server is updated.
+func syncBranch(ctx context.Context, scmClient scm.Client, protection configv1alpha1.BranchProtection, onChange func(created bool, drift []string)) (bool, []string, error) {
+	desired := scm.BranchProtection{
+		RequiredStatusContexts: protection.RequiredStatusContexts,
+		RequiredApprovals:      protection.RequiredApprovals,
+		DismissStaleReviews:    protection.DismissStaleReviews,
+		PushTeams:              protection.PushTeams,
+		EnforceAdmins:          protection.EnforceAdmins,
+	}
+	actual, err := scmClient.GetBranchProtection(ctx, protection.Branch)
+	if err != nil {
+		return false, nil, err
+	}
+	if actual == nil {
+		// the first sync of an unprotected branch is not a drift
+		onChange(true, nil)
+		return true, nil, scmClient.UpdateBranchProtection(ctx, protection.Branch, desired)
+	}
+	drift := branchProtectionDrift(desired, *actual)
+	if len(drift) == 0 {
+		return false, nil, nil
+	}
+	onChange(false, drift)
+	return false, drift, scmClient.UpdateBranchProtection(ctx, protection.Branch, desired)
+}
+
+// branchProtectionDrift returns the rules of the actual branch protection that differ from the desired state
+func branchProtectionDrift(desired, actual scm.BranchProtection) []string {
+	var drift []string
+	if !sameStrings(desired.RequiredStatusContexts, actual.RequiredStatusContexts) {
+		drift = append(drift, "requiredStatusContexts")
+	}
+	if desired.RequiredApprovals != actual.RequiredApprovals {
+		drift = append(drift, "requiredApprovals")
+	}
+	if desired.DismissStaleReviews != actual.DismissStaleReviews {
+		drift = append(drift, "dismissStaleReviews")
+	}
+	if !sameStrings(desired.PushTeams, actual.PushTeams) {
+		drift = append(drift, "pushTeams")
+	}
+	if desired.EnforceAdmins != actual.EnforceAdmins {
+		drift = append(drift, "enforceAdmins")
+	}
+	return drift
+}
+
+// sameStrings compares two lists of strings ignoring their order
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a, b = append([]string(nil), a...), append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
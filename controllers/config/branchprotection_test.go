@@ -0,0 +1,181 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	configv1alpha1 "github.com/kloops-io/kloops/apis/config/v1alpha1"
+	"github.com/kloops-io/kloops/pkg/scm"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// fakeBranchProtectionSCM is a git server holding branch protection rules,
+// branches listed in unsupported fail with scm.ErrUnsupported
+type fakeBranchProtectionSCM struct {
+	scm.Client
+	protections map[string]*scm.BranchProtection
+	unsupported map[string]bool
+	updated     []string
+}
+
+func (f *fakeBranchProtectionSCM) GetBranchProtection(_ context.Context, branch string) (*scm.BranchProtection, error) {
+	if f.unsupported[branch] {
+		return nil, scm.ErrUnsupported
+	}
+	return f.protections[branch], nil
+}
+
+func (f *fakeBranchProtectionSCM) UpdateBranchProtection(_ context.Context, branch string, protection scm.BranchProtection) error {
+	f.updated = append(f.updated, branch)
+	f.protections[branch] = &protection
+	return nil
+}
+
+func TestBranchProtectionDrift(t *testing.T) {
+	desired := scm.BranchProtection{
+		RequiredStatusContexts: []string{"ci", "lint"},
+		RequiredApprovals:      2,
+		DismissStaleReviews:    true,
+		PushTeams:              []string{"admins"},
+		EnforceAdmins:          true,
+	}
+	tests := []struct {
+		name   string
+		actual func(*scm.BranchProtection)
+		want   []string
+	}{{
+		name:   "in sync",
+		actual: func(*scm.BranchProtection) {},
+	}, {
+		name:   "contexts in another order",
+		actual: func(p *scm.BranchProtection) { p.RequiredStatusContexts = []string{"lint", "ci"} },
+	}, {
+		name:   "missing context",
+		actual: func(p *scm.BranchProtection) { p.RequiredStatusContexts = []string{"ci"} },
+		want:   []string{"requiredStatusContexts"},
+	}, {
+		name: "several rules",
+		actual: func(p *scm.BranchProtection) {
+			p.RequiredApprovals = 1
+			p.DismissStaleReviews = false
+			p.PushTeams = nil
+			p.EnforceAdmins = false
+		},
+		want: []string{"requiredApprovals", "dismissStaleReviews", "pushTeams", "enforceAdmins"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := desired
+			actual.RequiredStatusContexts = append([]string(nil), desired.RequiredStatusContexts...)
+			tt.actual(&actual)
+			if got := branchProtectionDrift(desired, actual); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("branchProtectionDrift() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSyncBranchProtection(t *testing.T) {
+	rules := func(branch string, approvals int) configv1alpha1.BranchProtection {
+		return configv1alpha1.BranchProtection{Branch: branch, RequiredStatusContexts: []string{"ci"}, RequiredApprovals: approvals}
+	}
+	tests := []struct {
+		name          string
+		protection    []configv1alpha1.BranchProtection
+		actual        map[string]*scm.BranchProtection
+		unsupported   map[string]bool
+		wantCondition corev1.ConditionStatus
+		wantReason    string
+		wantStatus    []configv1alpha1.BranchProtectionStatus
+		wantUpdated   []string
+		wantEvents    []string
+	}{{
+		name:          "in sync",
+		protection:    []configv1alpha1.BranchProtection{rules("main", 1)},
+		actual:        map[string]*scm.BranchProtection{"main": {RequiredStatusContexts: []string{"ci"}, RequiredApprovals: 1}},
+		wantCondition: corev1.ConditionTrue,
+		wantReason:    "Synced",
+		wantStatus:    []configv1alpha1.BranchProtectionStatus{{Branch: "main"}},
+	}, {
+		name:          "unprotected branch is created, not drifted",
+		protection:    []configv1alpha1.BranchProtection{rules("main", 1)},
+		actual:        map[string]*scm.BranchProtection{},
+		wantCondition: corev1.ConditionTrue,
+		wantReason:    "Created",
+		wantStatus:    []configv1alpha1.BranchProtectionStatus{{Branch: "main"}},
+		wantUpdated:   []string{"main"},
+		wantEvents:    []string{"Normal BranchProtectionCreated branch main is not protected on the git server, creating its protection rules"},
+	}, {
+		name:          "drift is corrected",
+		protection:    []configv1alpha1.BranchProtection{rules("main", 2)},
+		actual:        map[string]*scm.BranchProtection{"main": {RequiredStatusContexts: []string{"ci"}, RequiredApprovals: 1}},
+		wantCondition: corev1.ConditionTrue,
+		wantReason:    "DriftCorrected",
+		wantStatus:    []configv1alpha1.BranchProtectionStatus{{Branch: "main", Drift: []string{"requiredApprovals"}}},
+		wantUpdated:   []string{"main"},
+		wantEvents:    []string{"Warning BranchProtectionDrift branch main protection rules requiredApprovals drifted on the git server, overwriting them"},
+	}, {
+		name:          "keeps syncing after an unsupported branch",
+		protection:    []configv1alpha1.BranchProtection{rules("release-*", 1), rules("main", 1)},
+		actual:        map[string]*scm.BranchProtection{},
+		unsupported:   map[string]bool{"release-*": true},
+		wantCondition: corev1.ConditionFalse,
+		wantReason:    "Unsupported",
+		wantStatus: []configv1alpha1.BranchProtectionStatus{
+			{Branch: "release-*", Error: scm.ErrUnsupported.Error()},
+			{Branch: "main"},
+		},
+		wantUpdated: []string{"main"},
+		wantEvents:  []string{"Normal BranchProtectionCreated branch main is not protected on the git server, creating its protection rules"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scmClient := &fakeBranchProtectionSCM{protections: tt.actual, unsupported: tt.unsupported}
+			recorder := record.NewFakeRecorder(10)
+			r := &RepoConfigReconciler{Log: logf.NullLogger{}, Recorder: recorder}
+			repoConfig := &configv1alpha1.RepoConfig{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "repo"},
+				Spec:       configv1alpha1.RepoConfigSpec{BranchProtection: tt.protection},
+			}
+			var status configv1alpha1.RepoConfigStatus
+			condition := r.syncBranchProtection(context.Background(), r.Log, repoConfig, scmClient, &status)
+			if condition.Status != tt.wantCondition || condition.Reason != tt.wantReason {
+				t.Errorf("condition = %+v, want %s %s", condition, tt.wantCondition, tt.wantReason)
+			}
+			if !reflect.DeepEqual(status.BranchProtection, tt.wantStatus) {
+				t.Errorf("status = %+v, want %+v", status.BranchProtection, tt.wantStatus)
+			}
+			if !reflect.DeepEqual(scmClient.updated, tt.wantUpdated) {
+				t.Errorf("updated branches = %v, want %v", scmClient.updated, tt.wantUpdated)
+			}
+			close(recorder.Events)
+			var events []string
+			for event := range recorder.Events {
+				events = append(events, event)
+			}
+			if !reflect.DeepEqual(events, tt.wantEvents) {
+				t.Errorf("events = %v, want %v", events, tt.wantEvents)
+			}
+		})
+	}
+}
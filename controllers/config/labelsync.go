@@ -0,0 +1,133 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	configv1alpha1 "github.com/kloops-io/kloops/apis/config/v1alpha1"
+	"github.com/kloops-io/kloops/pkg/scm"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// labelOperation is a change to apply to a repository label
+type labelOperation struct {
+	change configv1alpha1.LabelChange
+	label  scm.Label
+}
+
+// syncLabels creates, updates, renames and deletes the repository labels to match the label definitions
+// of the repo config, the changes are recorded in the status (and only recorded in dry-run mode)
+func (r *RepoConfigReconciler) syncLabels(ctx context.Context, log logr.Logger, repoConfig *configv1alpha1.RepoConfig, scmClient scm.Client, status *configv1alpha1.RepoConfigStatus) configv1alpha1.Condition {
+	status.LabelChanges = nil
+	labelSync := repoConfig.Spec.LabelSync
+	if labelSync == nil {
+		return newCondition(configv1alpha1.RepoConfigLabelsSynced, corev1.ConditionTrue, "NoLabelSync", "no label definitions")
+	}
+	if scmClient == nil {
+		return newCondition(configv1alpha1.RepoConfigLabelsSynced, corev1.ConditionUnknown, "TokenInvalid", "labels cannot be synced without a valid token")
+	}
+	labels, err := scmClient.ListLabels(ctx)
+	if errors.Is(err, scm.ErrUnsupported) {
+		return newCondition(configv1alpha1.RepoConfigLabelsSynced, corev1.ConditionFalse, "Unsupported", err.Error())
+	}
+	if err != nil {
+		return newCondition(configv1alpha1.RepoConfigLabelsSynced, corev1.ConditionUnknown, "ListFailed", err.Error())
+	}
+	operations := planLabels(labelSync, labels)
+	for _, operation := range operations {
+		status.LabelChanges = append(status.LabelChanges, operation.change)
+	}
+	if labelSync.DryRun {
+		return newCondition(configv1alpha1.RepoConfigLabelsSynced, corev1.ConditionTrue, "DryRun", fmt.Sprintf("%d label changes planned", len(operations)))
+	}
+	for _, operation := range operations {
+		if err := applyLabel(ctx, scmClient, operation); err != nil {
+			return newCondition(configv1alpha1.RepoConfigLabelsSynced, corev1.ConditionFalse, "SyncFailed", fmt.Sprintf("failed to %s label %s: %s", operation.change.Action, operation.change.Label, err))
+		}
+		log.Info("label synced", "action", operation.change.Action, "label", operation.change.Label)
+	}
+	return newCondition(configv1alpha1.RepoConfigLabelsSynced, corev1.ConditionTrue, "Synced", fmt.Sprintf("%d label changes applied", len(operations)))
+}
+
+// planLabels returns the operations needed to make the repository labels match the definitions, label names are case insensitive
+func planLabels(labelSync *configv1alpha1.LabelSync, labels []scm.Label) []labelOperation {
+	existing := map[string]scm.Label{}
+	for _, label := range labels {
+		existing[strings.ToLower(label.Name)] = label
+	}
+	defined := map[string]bool{}
+	var operations []labelOperation
+	for _, definition := range labelSync.Labels {
+		desired := scm.Label{Name: definition.Name, Color: strings.ToLower(definition.Color), Description: definition.Description}
+		defined[strings.ToLower(definition.Name)] = true
+		if label, ok := existing[strings.ToLower(definition.Name)]; ok {
+			if label.Name != desired.Name || !strings.EqualFold(label.Color, desired.Color) || label.Description != desired.Description {
+				operations = append(operations, labelOperation{
+					change: configv1alpha1.LabelChange{Action: configv1alpha1.LabelUpdate, Label: label.Name},
+					label:  desired,
+				})
+			}
+			continue
+		}
+		renamed := false
+		for _, previousName := range definition.PreviousNames {
+			if label, ok := existing[strings.ToLower(previousName)]; ok && !renamed {
+				defined[strings.ToLower(previousName)] = true
+				operations = append(operations, labelOperation{
+					change: configv1alpha1.LabelChange{Action: configv1alpha1.LabelRename, Label: definition.Name, PreviousName: label.Name},
+					label:  desired,
+				})
+				renamed = true
+			}
+		}
+		if !renamed {
+			operations = append(operations, labelOperation{
+				change: configv1alpha1.LabelChange{Action: configv1alpha1.LabelCreate, Label: definition.Name},
+				label:  desired,
+			})
+		}
+	}
+	if labelSync.DeleteUndefined {
+		for _, label := range labels {
+			if !defined[strings.ToLower(label.Name)] {
+				operations = append(operations, labelOperation{
+					change: configv1alpha1.LabelChange{Action: configv1alpha1.LabelDelete, Label: label.Name},
+				})
+			}
+		}
+	}
+	return operations
+}
+
+func applyLabel(ctx context.Context, scmClient scm.Client, operation labelOperation) error {
+	switch operation.change.Action {
+	case configv1alpha1.LabelCreate:
+		return scmClient.CreateLabel(ctx, operation.label)
+	case configv1alpha1.LabelUpdate:
+		return scmClient.UpdateLabel(ctx, operation.change.Label, operation.label)
+	case configv1alpha1.LabelRename:
+		return scmClient.UpdateLabel(ctx, operation.change.PreviousName, operation.label)
+	case configv1alpha1.LabelDelete:
+		return scmClient.DeleteLabel(ctx, operation.change.Label)
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	configv1alpha1 "github.com/kloops-io/kloops/apis/config/v1alpha1"
+	"github.com/kloops-io/kloops/pkg/scm"
+	corev1 "k8s.io/api/core/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// fakeLabelSCM is a git server holding repository labels and recording the label calls
+type fakeLabelSCM struct {
+	scm.Client
+	labels []scm.Label
+	calls  []string
+}
+
+func (f *fakeLabelSCM) ListLabels(context.Context) ([]scm.Label, error) {
+	return f.labels, nil
+}
+
+func (f *fakeLabelSCM) CreateLabel(_ context.Context, label scm.Label) error {
+	f.calls = append(f.calls, "create "+label.Name)
+	return nil
+}
+
+func (f *fakeLabelSCM) UpdateLabel(_ context.Context, name string, label scm.Label) error {
+	f.calls = append(f.calls, "update "+name+" to "+label.Name)
+	return nil
+}
+
+func (f *fakeLabelSCM) DeleteLabel(_ context.Context, name string) error {
+	f.calls = append(f.calls, "delete "+name)
+	return nil
+}
+
+func TestPlanLabels(t *testing.T) {
+	bug := configv1alpha1.LabelDefinition{Name: "kind/bug", Color: "D73A4A", Description: "Something is broken", PreviousNames: []string{"bug", "defect"}}
+	tests := []struct {
+		name      string
+		labelSync configv1alpha1.LabelSync
+		labels    []scm.Label
+		want      []labelOperation
+	}{{
+		name:      "create",
+		labelSync: configv1alpha1.LabelSync{Labels: []configv1alpha1.LabelDefinition{bug}},
+		want: []labelOperation{{
+			change: configv1alpha1.LabelChange{Action: configv1alpha1.LabelCreate, Label: "kind/bug"},
+			label:  scm.Label{Name: "kind/bug", Color: "d73a4a", Description: "Something is broken"},
+		}},
+	}, {
+		name:      "in sync",
+		labelSync: configv1alpha1.LabelSync{Labels: []configv1alpha1.LabelDefinition{bug}},
+		labels:    []scm.Label{{Name: "kind/bug", Color: "d73a4a", Description: "Something is broken"}},
+	}, {
+		name:      "case insensitive match, colors compared ignoring case",
+		labelSync: configv1alpha1.LabelSync{Labels: []configv1alpha1.LabelDefinition{bug}},
+		labels:    []scm.Label{{Name: "Kind/Bug", Color: "D73A4A", Description: "Something is broken"}},
+		want: []labelOperation{{
+			change: configv1alpha1.LabelChange{Action: configv1alpha1.LabelUpdate, Label: "Kind/Bug"},
+			label:  scm.Label{Name: "kind/bug", Color: "d73a4a", Description: "Something is broken"},
+		}},
+	}, {
+		name:      "update",
+		labelSync: configv1alpha1.LabelSync{Labels: []configv1alpha1.LabelDefinition{bug}},
+		labels:    []scm.Label{{Name: "kind/bug", Color: "ffffff"}},
+		want: []labelOperation{{
+			change: configv1alpha1.LabelChange{Action: configv1alpha1.LabelUpdate, Label: "kind/bug"},
+			label:  scm.Label{Name: "kind/bug", Color: "d73a4a", Description: "Something is broken"},
+		}},
+	}, {
+		name:      "rename via previous names",
+		labelSync: configv1alpha1.LabelSync{Labels: []configv1alpha1.LabelDefinition{bug}, DeleteUndefined: true},
+		labels:    []scm.Label{{Name: "Defect", Color: "d73a4a"}, {Name: "bug", Color: "d73a4a"}},
+		want: []labelOperation{{
+			// the first previous name is renamed, the label with the other previous name is a leftover
+			change: configv1alpha1.LabelChange{Action: configv1alpha1.LabelRename, Label: "kind/bug", PreviousName: "bug"},
+			label:  scm.Label{Name: "kind/bug", Color: "d73a4a", Description: "Something is broken"},
+		}, {
+			change: configv1alpha1.LabelChange{Action: configv1alpha1.LabelDelete, Label: "Defect"},
+		}},
+	}, {
+		name:      "rename target already exists",
+		labelSync: configv1alpha1.LabelSync{Labels: []configv1alpha1.LabelDefinition{bug}},
+		labels:    []scm.Label{{Name: "bug", Color: "d73a4a"}, {Name: "kind/bug", Color: "d73a4a", Description: "Something is broken"}},
+	}, {
+		name:      "rename target already exists, previous name deleted",
+		labelSync: configv1alpha1.LabelSync{Labels: []configv1alpha1.LabelDefinition{bug}, DeleteUndefined: true},
+		labels:    []scm.Label{{Name: "bug", Color: "d73a4a"}, {Name: "kind/bug", Color: "d73a4a", Description: "Something is broken"}},
+		want: []labelOperation{{
+			change: configv1alpha1.LabelChange{Action: configv1alpha1.LabelDelete, Label: "bug"},
+		}},
+	}, {
+		name:      "undefined labels kept",
+		labelSync: configv1alpha1.LabelSync{Labels: []configv1alpha1.LabelDefinition{bug}},
+		labels:    []scm.Label{{Name: "kind/bug", Color: "d73a4a", Description: "Something is broken"}, {Name: "wontfix"}},
+	}, {
+		name:      "undefined labels deleted",
+		labelSync: configv1alpha1.LabelSync{Labels: []configv1alpha1.LabelDefinition{bug}, DeleteUndefined: true},
+		labels:    []scm.Label{{Name: "KIND/BUG", Color: "d73a4a", Description: "Something is broken"}, {Name: "wontfix"}},
+		want: []labelOperation{{
+			change: configv1alpha1.LabelChange{Action: configv1alpha1.LabelUpdate, Label: "KIND/BUG"},
+			label:  scm.Label{Name: "kind/bug", Color: "d73a4a", Description: "Something is broken"},
+		}, {
+			change: configv1alpha1.LabelChange{Action: configv1alpha1.LabelDelete, Label: "wontfix"},
+		}},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := planLabels(&tt.labelSync, tt.labels); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("planLabels() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSyncLabels(t *testing.T) {
+	labelSync := &configv1alpha1.LabelSync{
+		Labels: []configv1alpha1.LabelDefinition{
+			{Name: "kind/bug", Color: "d73a4a", PreviousNames: []string{"bug"}},
+			{Name: "kind/feature", Color: "a2eeef"},
+		},
+		DeleteUndefined: true,
+	}
+	labels := []scm.Label{{Name: "bug", Color: "d73a4a"}, {Name: "wontfix"}}
+	wantChanges := []configv1alpha1.LabelChange{
+		{Action: configv1alpha1.LabelRename, Label: "kind/bug", PreviousName: "bug"},
+		{Action: configv1alpha1.LabelCreate, Label: "kind/feature"},
+		{Action: configv1alpha1.LabelDelete, Label: "wontfix"},
+	}
+	tests := []struct {
+		name       string
+		dryRun     bool
+		wantReason string
+		wantCalls  []string
+	}{{
+		name:       "apply",
+		wantReason: "Synced",
+		wantCalls:  []string{"update bug to kind/bug", "create kind/feature", "delete wontfix"},
+	}, {
+		name:       "dry run",
+		dryRun:     true,
+		wantReason: "DryRun",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scmClient := &fakeLabelSCM{labels: labels}
+			repoConfig := &configv1alpha1.RepoConfig{Spec: configv1alpha1.RepoConfigSpec{LabelSync: labelSync.DeepCopy()}}
+			repoConfig.Spec.LabelSync.DryRun = tt.dryRun
+			r := &RepoConfigReconciler{Log: logf.NullLogger{}}
+			var status configv1alpha1.RepoConfigStatus
+			condition := r.syncLabels(context.Background(), r.Log, repoConfig, scmClient, &status)
+			if condition.Status != corev1.ConditionTrue || condition.Reason != tt.wantReason {
+				t.Errorf("condition = %+v, want true %s", condition, tt.wantReason)
+			}
+			if !reflect.DeepEqual(status.LabelChanges, wantChanges) {
+				t.Errorf("label changes = %+v, want %+v", status.LabelChanges, wantChanges)
+			}
+			if !reflect.DeepEqual(scmClient.calls, tt.wantCalls) {
+				t.Errorf("calls = %v, want %v", scmClient.calls, tt.wantCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,333 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	configv1alpha1 "github.com/kloops-io/kloops/apis/config/v1alpha1"
+	"github.com/kloops-io/kloops/pkg/scm"
+	"github.com/kloops-io/kloops/pkg/secrets"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// OrgConfigReconciler reconciles an OrgConfig object.
+// It registers the webhooks of the repositories matched by the org config that have no RepoConfig,
+// repositories with a RepoConfig are reconciled by the RepoConfigReconciler.
+type OrgConfigReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	// HookURL is the public url git servers send webhooks to.
+	// When set, the repository webhooks are registered on the git server and removed when the OrgConfig is deleted.
+	HookURL string
+	// ResyncPeriod is the period at which org configs are verified again, picking up new repositories
+	ResyncPeriod time.Duration
+}
+
+// +kubebuilder:rbac:groups=config.kloops.io,resources=orgconfigs,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=config.kloops.io,resources=orgconfigs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=config.kloops.io,resources=repoconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// Reconcile lists the repositories of an OrgConfig organization, registers the webhooks of the repositories
+// it configures on its own and records the results in its status conditions
+func (r *OrgConfigReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("orgconfig", req.NamespacedName)
+
+	var orgConfig configv1alpha1.OrgConfig
+	if err := r.Get(ctx, req.NamespacedName, &orgConfig); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !orgConfig.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, r.finalize(ctx, log, &orgConfig)
+	}
+	if r.HookURL != "" && !controllerutil.ContainsFinalizer(&orgConfig, WebhookFinalizer) {
+		controllerutil.AddFinalizer(&orgConfig, WebhookFinalizer)
+		if err := r.Update(ctx, &orgConfig); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	status := orgConfig.Status.DeepCopy()
+	scmClient, tokenCondition := r.verifyToken(ctx, &orgConfig)
+	configv1alpha1.SetCondition(&status.Conditions, tokenCondition)
+	repos, overridden, reposCondition := r.listRepos(ctx, &orgConfig, scmClient)
+	configv1alpha1.SetCondition(&status.Conditions, reposCondition)
+	configv1alpha1.SetCondition(&status.Conditions, r.reconcileWebhooks(ctx, log, &orgConfig, repos, overridden, status))
+	configv1alpha1.SetCondition(&status.Conditions, aggregateCondition(configv1alpha1.OrgConfigReady, status.Conditions, "org config is ready"))
+	status.ObservedGeneration = orgConfig.Generation
+
+	if !equality.Semantic.DeepEqual(status, &orgConfig.Status) {
+		orgConfig.Status = *status
+		if err := r.Status().Update(ctx, &orgConfig); err != nil {
+			return ctrl.Result{}, err
+		}
+		log.Info("status updated", "ready", configv1alpha1.IsConditionTrue(status.Conditions, configv1alpha1.OrgConfigReady), "repositories", len(status.Repositories))
+	}
+	return ctrl.Result{RequeueAfter: r.resyncPeriod()}, nil
+}
+
+// SetupWithManager sets up the controller with the manager
+func (r *OrgConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&configv1alpha1.OrgConfig{}).
+		Watches(&source.Kind{Type: &configv1alpha1.RepoConfig{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.orgConfigsForRepoConfig),
+		}).
+		Complete(r)
+}
+
+// orgConfigsForRepoConfig returns the org configs of the repo config organization,
+// a repository gaining or losing its RepoConfig is taken over from or handed back to them
+func (r *OrgConfigReconciler) orgConfigsForRepoConfig(obj handler.MapObject) []reconcile.Request {
+	repoConfig, ok := obj.Object.(*configv1alpha1.RepoConfig)
+	if !ok {
+		return nil
+	}
+	kind, _ := repoConfig.Spec.GitServer()
+	owner, _ := repoConfig.Spec.Repository()
+	var list configv1alpha1.OrgConfigList
+	if err := r.List(context.Background(), &list); err != nil {
+		r.Log.Error(err, "failed to list org configs")
+		return nil
+	}
+	var requests []reconcile.Request
+	for i := range list.Items {
+		orgConfig := &list.Items[i]
+		if orgKind, _ := orgConfig.Spec.GitServer(); orgKind == kind && orgConfig.Spec.Owner() == owner {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: orgConfig.Namespace, Name: orgConfig.Name}})
+		}
+	}
+	return requests
+}
+
+func (r *OrgConfigReconciler) resyncPeriod() time.Duration {
+	if r.ResyncPeriod <= 0 {
+		return DefaultResyncPeriod
+	}
+	return r.ResyncPeriod
+}
+
+func (r *OrgConfigReconciler) verifyToken(ctx context.Context, orgConfig *configv1alpha1.OrgConfig) (scm.Client, configv1alpha1.Condition) {
+	scmClient, err := scm.NewOrgClientFromSpec(ctx, r, orgConfig.Namespace, &orgConfig.Spec)
+	if errors.Is(err, scm.ErrNoProvider) {
+		return nil, newCondition(configv1alpha1.OrgConfigTokenValid, corev1.ConditionFalse, "NoGitServer", err.Error())
+	}
+	if err != nil {
+		return nil, newCondition(configv1alpha1.OrgConfigTokenValid, corev1.ConditionFalse, "SecretNotResolved", err.Error())
+	}
+	user, err := scmClient.CurrentUser(ctx)
+	if err != nil {
+		return nil, newCondition(configv1alpha1.OrgConfigTokenValid, corev1.ConditionFalse, "AuthenticationFailed", err.Error())
+	}
+	return scmClient, newCondition(configv1alpha1.OrgConfigTokenValid, corev1.ConditionTrue, "Authenticated", fmt.Sprintf("authenticated as %s", user))
+}
+
+// listRepos returns the sorted names of the repositories the org config configures on its own,
+// and the names of the matched repositories overridden by a RepoConfig. The names are nil when they cannot be listed.
+func (r *OrgConfigReconciler) listRepos(ctx context.Context, orgConfig *configv1alpha1.OrgConfig, scmClient scm.Client) ([]string, map[string]bool, configv1alpha1.Condition) {
+	if scmClient == nil {
+		return nil, nil, newCondition(configv1alpha1.OrgConfigReposListed, corev1.ConditionUnknown, "TokenInvalid", "repositories cannot be listed without a valid token")
+	}
+	overridden, err := r.overriddenRepos(ctx, orgConfig)
+	if err != nil {
+		return nil, nil, newCondition(configv1alpha1.OrgConfigReposListed, corev1.ConditionUnknown, "RepoConfigsUnknown", err.Error())
+	}
+	names, err := scm.ListOrgRepos(ctx, scmClient, orgConfig.Spec.Owner())
+	if err != nil {
+		return nil, nil, newCondition(configv1alpha1.OrgConfigReposListed, corev1.ConditionUnknown, "ListFailed", err.Error())
+	}
+	repos := []string{}
+	for _, name := range names {
+		if orgConfig.Spec.Matches(name) && !overridden[name] {
+			repos = append(repos, name)
+		}
+	}
+	sort.Strings(repos)
+	return repos, overridden, newCondition(configv1alpha1.OrgConfigReposListed, corev1.ConditionTrue, "Listed",
+		fmt.Sprintf("%d repositories configured by the org config, %d overridden by a repo config", len(repos), len(overridden)))
+}
+
+// overriddenRepos returns the names of the repositories of the org config organization that have a RepoConfig, in any namespace
+func (r *OrgConfigReconciler) overriddenRepos(ctx context.Context, orgConfig *configv1alpha1.OrgConfig) (map[string]bool, error) {
+	var repoConfigs configv1alpha1.RepoConfigList
+	if err := r.List(ctx, &repoConfigs); err != nil {
+		return nil, fmt.Errorf("failed to list repo configs: %w", err)
+	}
+	var orgConfigs configv1alpha1.OrgConfigList
+	if err := r.List(ctx, &orgConfigs); err != nil {
+		return nil, fmt.Errorf("failed to list org configs: %w", err)
+	}
+	// repo configs referencing an org config inherit its server url
+	serverURLs := map[types.NamespacedName]string{}
+	for i := range orgConfigs.Items {
+		_, serverURL := orgConfigs.Items[i].Spec.GitServer()
+		serverURLs[types.NamespacedName{Namespace: orgConfigs.Items[i].Namespace, Name: orgConfigs.Items[i].Name}] = serverURL
+	}
+	kind, serverURL := orgConfig.Spec.GitServer()
+	overridden := map[string]bool{}
+	for i := range repoConfigs.Items {
+		repoConfig := &repoConfigs.Items[i]
+		repoKind, repoServerURL := repoConfig.Spec.GitServer()
+		if repoServerURL == "" && repoConfig.Spec.OrgConfig != "" {
+			repoServerURL = serverURLs[types.NamespacedName{Namespace: repoConfig.Namespace, Name: repoConfig.Spec.OrgConfig}]
+		}
+		if owner, name := repoConfig.Spec.Repository(); repoKind == kind && repoServerURL == serverURL && owner == orgConfig.Spec.Owner() && orgConfig.Spec.Matches(name) {
+			overridden[name] = true
+		}
+	}
+	return overridden, nil
+}
+
+// reconcileWebhooks registers the webhooks of the repositories and removes the webhooks of the repositories the org config
+// no longer matches, the webhooks of repositories overridden by a RepoConfig are left to the RepoConfigReconciler
+func (r *OrgConfigReconciler) reconcileWebhooks(ctx context.Context, log logr.Logger, orgConfig *configv1alpha1.OrgConfig, repos []string, overridden map[string]bool, status *configv1alpha1.OrgConfigStatus) configv1alpha1.Condition {
+	if repos == nil {
+		return newCondition(configv1alpha1.OrgConfigWebhooksRegistered, corev1.ConditionUnknown, "ReposUnknown", "webhooks cannot be registered without the repository list")
+	}
+	if r.HookURL == "" {
+		status.Repositories = nil
+		for _, name := range repos {
+			status.Repositories = append(status.Repositories, configv1alpha1.OrgRepoStatus{Name: name})
+		}
+		// webhooks are registered by hand, there is nothing to report
+		return newCondition(configv1alpha1.OrgConfigWebhooksRegistered, corev1.ConditionTrue, "NotManaged", "the public hook url is not configured, webhooks are not registered by the controller")
+	}
+	spec := orgConfig.Spec.ForRepo("")
+	hmacTokenSecret, err := scm.HmacTokenSecret(&spec)
+	if err != nil {
+		return newCondition(configv1alpha1.OrgConfigWebhooksRegistered, corev1.ConditionFalse, "NoGitServer", err.Error())
+	}
+	hmacToken, err := secrets.Get(ctx, r, orgConfig.Namespace, hmacTokenSecret)
+	if err != nil {
+		return newCondition(configv1alpha1.OrgConfigWebhooksRegistered, corev1.ConditionFalse, "SecretNotResolved", err.Error())
+	}
+	hookSpec := scm.HookSpec{URL: r.HookURL, Secret: hmacToken}
+
+	previous := map[string]configv1alpha1.OrgRepoStatus{}
+	for _, repoStatus := range status.Repositories {
+		previous[repoStatus.Name] = repoStatus
+	}
+	var repoStatuses []configv1alpha1.OrgRepoStatus
+	var failed []string
+	for _, name := range repos {
+		repoStatus, ok := previous[name]
+		if !ok {
+			repoStatus = configv1alpha1.OrgRepoStatus{Name: name}
+		}
+		delete(previous, name)
+		repoStatus.Error = ""
+		if err := r.registerWebhook(ctx, log.WithValues("repository", name), orgConfig, hookSpec, &repoStatus); err != nil {
+			repoStatus.Error = err.Error()
+			failed = append(failed, name)
+		}
+		repoStatuses = append(repoStatuses, repoStatus)
+	}
+	for name, repoStatus := range previous {
+		if overridden[name] {
+			continue
+		}
+		if err := r.removeWebhook(ctx, log.WithValues("repository", name), orgConfig, repoStatus); err != nil {
+			// the repository is kept in the status until its webhook is removed
+			repoStatus.Error = fmt.Sprintf("failed to remove webhook: %v", err)
+			repoStatuses = append(repoStatuses, repoStatus)
+			failed = append(failed, name)
+		}
+	}
+	sort.Slice(repoStatuses, func(i, j int) bool { return repoStatuses[i].Name < repoStatuses[j].Name })
+	sort.Strings(failed)
+	status.Repositories = repoStatuses
+	if len(failed) != 0 {
+		return newCondition(configv1alpha1.OrgConfigWebhooksRegistered, corev1.ConditionFalse, "RegistrationFailed",
+			fmt.Sprintf("webhooks of %d repositories failed to reconcile: %s", len(failed), strings.Join(failed, ", ")))
+	}
+	return newCondition(configv1alpha1.OrgConfigWebhooksRegistered, corev1.ConditionTrue, "Registered", fmt.Sprintf("webhooks of %d repositories point at %s", len(repoStatuses), r.HookURL))
+}
+
+// registerWebhook registers the webhook of a repository and records it in the repository status
+func (r *OrgConfigReconciler) registerWebhook(ctx context.Context, log logr.Logger, orgConfig *configv1alpha1.OrgConfig, hookSpec scm.HookSpec, repoStatus *configv1alpha1.OrgRepoStatus) error {
+	spec := orgConfig.Spec.ForRepo(repoStatus.Name)
+	scmClient, err := scm.NewClientFromSpec(ctx, r, orgConfig.Namespace, &spec)
+	if err != nil {
+		return err
+	}
+	hook, _, err := ensureWebhook(ctx, log, scmClient, hookSpec, repoStatus.WebhookID, repoStatus.WebhookSecretHash)
+	if err != nil {
+		return err
+	}
+	repoStatus.WebhookID = hook.ID
+	repoStatus.WebhookSecretHash = webhookSecretHash(hookSpec)
+	if d := hook.LastDelivery; d != nil && d.Failed() {
+		return fmt.Errorf("last delivery to webhook %d failed with code %d: %s", hook.ID, d.Code, d.Message)
+	}
+	return nil
+}
+
+// removeWebhook removes the webhook of a repository the org config no longer configures
+func (r *OrgConfigReconciler) removeWebhook(ctx context.Context, log logr.Logger, orgConfig *configv1alpha1.OrgConfig, repoStatus configv1alpha1.OrgRepoStatus) error {
+	spec := orgConfig.Spec.ForRepo(repoStatus.Name)
+	scmClient, err := scm.NewClientFromSpec(ctx, r, orgConfig.Namespace, &spec)
+	if err != nil {
+		return err
+	}
+	return removeWebhook(ctx, log, scmClient, repoStatus.WebhookID, r.HookURL)
+}
+
+func (r *OrgConfigReconciler) finalize(ctx context.Context, log logr.Logger, orgConfig *configv1alpha1.OrgConfig) error {
+	if !controllerutil.ContainsFinalizer(orgConfig, WebhookFinalizer) {
+		return nil
+	}
+	overridden, err := r.overriddenRepos(ctx, orgConfig)
+	if err != nil {
+		return err
+	}
+	for _, repoStatus := range orgConfig.Status.Repositories {
+		if overridden[repoStatus.Name] {
+			continue
+		}
+		spec := orgConfig.Spec.ForRepo(repoStatus.Name)
+		scmClient, err := scm.NewClientFromSpec(ctx, r, orgConfig.Namespace, &spec)
+		if err != nil {
+			// without credentials the webhooks cannot be removed, do not block the deletion forever
+			log.Error(err, "failed to resolve credentials, webhooks will not be removed")
+			break
+		}
+		if err := removeWebhook(ctx, log.WithValues("repository", repoStatus.Name), scmClient, repoStatus.WebhookID, r.HookURL); err != nil {
+			return err
+		}
+	}
+	controllerutil.RemoveFinalizer(orgConfig, WebhookFinalizer)
+	return r.Update(ctx, orgConfig)
+}
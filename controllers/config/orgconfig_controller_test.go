@@ -0,0 +1,237 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	configv1alpha1 "github.com/kloops-io/kloops/apis/config/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// fakeGitea is a fake Gitea api serving the repositories of an organization and their webhooks
+type fakeGitea struct {
+	mu     sync.Mutex
+	repos  []string
+	hooks  map[string][]map[string]interface{}
+	nextID int64
+}
+
+func (f *fakeGitea) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	path := strings.TrimPrefix(req.URL.Path, "/api/v1")
+	switch {
+	case path == "/user":
+		_ = json.NewEncoder(w).Encode(map[string]string{"login": "bot"})
+	case path == "/orgs/my-org/repos":
+		var repos []map[string]interface{}
+		if req.URL.Query().Get("page") == "1" {
+			for _, repo := range f.repos {
+				repos = append(repos, map[string]interface{}{"name": repo})
+			}
+			repos = append(repos, map[string]interface{}{"name": "archived", "archived": true})
+		}
+		_ = json.NewEncoder(w).Encode(repos)
+	case strings.HasPrefix(path, "/repos/my-org/"):
+		parts := strings.Split(strings.TrimPrefix(path, "/repos/my-org/"), "/")
+		repo := parts[0]
+		switch {
+		case req.Method == http.MethodGet && len(parts) == 2:
+			_ = json.NewEncoder(w).Encode(append([]map[string]interface{}{}, f.hooks[repo]...))
+		case req.Method == http.MethodPost && len(parts) == 2:
+			var in struct {
+				Config map[string]string `json:"config"`
+			}
+			_ = json.NewDecoder(req.Body).Decode(&in)
+			f.nextID++
+			hook := map[string]interface{}{"id": f.nextID, "active": true, "config": map[string]string{"url": in.Config["url"]}}
+			f.hooks[repo] = append(f.hooks[repo], hook)
+			_ = json.NewEncoder(w).Encode(hook)
+		case req.Method == http.MethodDelete && len(parts) == 3:
+			var kept []map[string]interface{}
+			for _, hook := range f.hooks[repo] {
+				if fmt.Sprint(hook["id"]) != parts[2] {
+					kept = append(kept, hook)
+				}
+			}
+			f.hooks[repo] = kept
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// hooked returns the sorted repositories having a webhook
+func (f *fakeGitea) hooked() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var repos []string
+	for repo, hooks := range f.hooks {
+		if len(hooks) != 0 {
+			repos = append(repos, repo)
+		}
+	}
+	sort.Strings(repos)
+	return repos
+}
+
+func newConfigScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := configv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return scheme
+}
+
+func TestOrgConfigReconcile(t *testing.T) {
+	gitea := &fakeGitea{repos: []string{"a", "b", "vendored-c"}, hooks: map[string][]map[string]interface{}{}}
+	server := httptest.NewServer(gitea)
+	defer server.Close()
+
+	orgConfig := &configv1alpha1.OrgConfig{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "my-org"},
+		Spec: configv1alpha1.OrgConfigSpec{
+			Gitea: &configv1alpha1.GiteaOrg{
+				Owner:     "my-org",
+				ServerURL: server.URL,
+				HmacToken: configv1alpha1.Secret{Value: "hmac"},
+				Token:     configv1alpha1.Secret{Value: "token"},
+			},
+			Exclude: []string{"vendored-*"},
+		},
+	}
+	// b has its own repo config, inheriting the server url
+	repoConfig := &configv1alpha1.RepoConfig{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "b"},
+		Spec: configv1alpha1.RepoConfigSpec{
+			OrgConfig: "my-org",
+			Gitea:     &configv1alpha1.GiteaRepo{Owner: "my-org", Repo: "b"},
+		},
+	}
+	c := fake.NewFakeClientWithScheme(newConfigScheme(t), orgConfig, repoConfig)
+	r := &OrgConfigReconciler{Client: c, Log: logf.NullLogger{}, HookURL: "https://hook.example.com"}
+	key := types.NamespacedName{Namespace: "ns", Name: "my-org"}
+	reconcile := func() *configv1alpha1.OrgConfig {
+		t.Helper()
+		if _, err := r.Reconcile(ctrl.Request{NamespacedName: key}); err != nil {
+			t.Fatal(err)
+		}
+		var out configv1alpha1.OrgConfig
+		if err := c.Get(context.Background(), key, &out); err != nil {
+			t.Fatal(err)
+		}
+		return &out
+	}
+
+	got := reconcile()
+	if !configv1alpha1.IsConditionTrue(got.Status.Conditions, configv1alpha1.OrgConfigReady) {
+		t.Fatalf("org config not ready: %+v", got.Status.Conditions)
+	}
+	if want := []configv1alpha1.OrgRepoStatus{{Name: "a", WebhookID: 1, WebhookSecretHash: got.Status.Repositories[0].WebhookSecretHash}}; !reflect.DeepEqual(got.Status.Repositories, want) {
+		t.Errorf("repositories = %+v, want %+v", got.Status.Repositories, want)
+	}
+	if hooked := gitea.hooked(); !reflect.DeepEqual(hooked, []string{"a"}) {
+		t.Errorf("webhooks registered on %v, want [a]", hooked)
+	}
+
+	// reconciling again keeps the registered webhook
+	reconcile()
+	if hooked := gitea.hooked(); !reflect.DeepEqual(hooked, []string{"a"}) || len(gitea.hooks["a"]) != 1 {
+		t.Errorf("webhooks registered on %v (%d on a), want one on a", hooked, len(gitea.hooks["a"]))
+	}
+
+	// a new repository is picked up, a repository the org config no longer matches loses its webhook
+	gitea.mu.Lock()
+	gitea.repos = append(gitea.repos, "d")
+	gitea.mu.Unlock()
+	got.Spec.Exclude = append(got.Spec.Exclude, "a")
+	if err := c.Update(context.Background(), got); err != nil {
+		t.Fatal(err)
+	}
+	got = reconcile()
+	if hooked := gitea.hooked(); !reflect.DeepEqual(hooked, []string{"d"}) {
+		t.Errorf("webhooks registered on %v, want [d]", hooked)
+	}
+	if len(got.Status.Repositories) != 1 || got.Status.Repositories[0].Name != "d" {
+		t.Errorf("repositories = %+v, want d", got.Status.Repositories)
+	}
+
+	// finalizing the org config removes the webhooks, the fake client does not handle deletion timestamps
+	if !controllerutil.ContainsFinalizer(got, WebhookFinalizer) {
+		t.Fatal("webhook finalizer not added")
+	}
+	if err := r.finalize(context.Background(), r.Log, got); err != nil {
+		t.Fatal(err)
+	}
+	if hooked := gitea.hooked(); len(hooked) != 0 {
+		t.Errorf("webhooks left on %v", hooked)
+	}
+}
+
+func TestOrgConfigReconcileNotManaged(t *testing.T) {
+	gitea := &fakeGitea{repos: []string{"a"}, hooks: map[string][]map[string]interface{}{}}
+	server := httptest.NewServer(gitea)
+	defer server.Close()
+
+	orgConfig := &configv1alpha1.OrgConfig{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "my-org"},
+		Spec: configv1alpha1.OrgConfigSpec{
+			Gitea: &configv1alpha1.GiteaOrg{Owner: "my-org", ServerURL: server.URL, Token: configv1alpha1.Secret{Value: "token"}},
+		},
+	}
+	c := fake.NewFakeClientWithScheme(newConfigScheme(t), orgConfig)
+	r := &OrgConfigReconciler{Client: c, Log: logf.NullLogger{}}
+	key := types.NamespacedName{Namespace: "ns", Name: "my-org"}
+	if _, err := r.Reconcile(ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatal(err)
+	}
+	var got configv1alpha1.OrgConfig
+	if err := c.Get(context.Background(), key, &got); err != nil {
+		t.Fatal(err)
+	}
+	condition := configv1alpha1.FindCondition(got.Status.Conditions, configv1alpha1.OrgConfigWebhooksRegistered)
+	if condition == nil || condition.Status != corev1.ConditionTrue || condition.Reason != "NotManaged" {
+		t.Errorf("webhooks condition = %+v, want true NotManaged", condition)
+	}
+	if len(got.Status.Repositories) != 1 || got.Status.Repositories[0].Name != "a" {
+		t.Errorf("repositories = %+v, want a", got.Status.Repositories)
+	}
+	if hooked := gitea.hooked(); len(hooked) != 0 || controllerutil.ContainsFinalizer(&got, WebhookFinalizer) {
+		t.Errorf("unexpected webhooks %v or finalizer", hooked)
+	}
+}
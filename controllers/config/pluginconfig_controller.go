@@ -0,0 +1,313 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	configv1alpha1 "github.com/kloops-io/kloops/apis/config/v1alpha1"
+	"github.com/kloops-io/kloops/pkg/secrets"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// PluginConfigReconciler reconciles a PluginConfig object
+type PluginConfigReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	// ResyncPeriod is the period at which plugin configs are verified again
+	ResyncPeriod time.Duration
+}
+
+// +kubebuilder:rbac:groups=config.kloops.io,resources=pluginconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=config.kloops.io,resources=pluginconfigs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=config.kloops.io,resources=repoconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=config.kloops.io,resources=orgconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// Reconcile validates a PluginConfig and records its consumers and the results in its status
+func (r *PluginConfigReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("pluginconfig", req.NamespacedName)
+
+	var pluginConfig configv1alpha1.PluginConfig
+	if err := r.Get(ctx, req.NamespacedName, &pluginConfig); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	status, err := r.status(ctx, configv1alpha1.PluginConfigKind, &pluginConfig.ObjectMeta, &pluginConfig.Spec, &pluginConfig.Status)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !equality.Semantic.DeepEqual(status, &pluginConfig.Status) {
+		pluginConfig.Status = *status
+		if err := r.Status().Update(ctx, &pluginConfig); err != nil {
+			return ctrl.Result{}, err
+		}
+		log.Info("status updated", "ready", configv1alpha1.IsConditionTrue(status.Conditions, configv1alpha1.PluginConfigReady), "consumers", len(status.Consumers))
+	}
+	return ctrl.Result{RequeueAfter: r.resyncPeriod()}, nil
+}
+
+// SetupWithManager sets up the controller with the manager
+func (r *PluginConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	mapper := &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(obj handler.MapObject) []reconcile.Request {
+			return r.referencedPluginConfigs(obj, configv1alpha1.PluginConfigKind)
+		}),
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&configv1alpha1.PluginConfig{}).
+		Watches(&source.Kind{Type: &configv1alpha1.RepoConfig{}}, mapper).
+		Watches(&source.Kind{Type: &configv1alpha1.OrgConfig{}}, mapper).
+		Complete(r)
+}
+
+// ClusterPluginConfigReconciler reconciles a ClusterPluginConfig object
+type ClusterPluginConfigReconciler struct {
+	PluginConfigReconciler
+}
+
+// +kubebuilder:rbac:groups=config.kloops.io,resources=clusterpluginconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=config.kloops.io,resources=clusterpluginconfigs/status,verbs=get;update;patch
+
+// Reconcile validates a ClusterPluginConfig and records its consumers and the results in its status.
+// Secrets referencing kubernetes secrets are resolved in the namespaces of the consumers.
+func (r *ClusterPluginConfigReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("clusterpluginconfig", req.Name)
+
+	var clusterPluginConfig configv1alpha1.ClusterPluginConfig
+	if err := r.Get(ctx, req.NamespacedName, &clusterPluginConfig); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	status, err := r.status(ctx, configv1alpha1.ClusterPluginConfigKind, &clusterPluginConfig.ObjectMeta, &clusterPluginConfig.Spec, &clusterPluginConfig.Status)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !equality.Semantic.DeepEqual(status, &clusterPluginConfig.Status) {
+		clusterPluginConfig.Status = *status
+		if err := r.Status().Update(ctx, &clusterPluginConfig); err != nil {
+			return ctrl.Result{}, err
+		}
+		log.Info("status updated", "ready", configv1alpha1.IsConditionTrue(status.Conditions, configv1alpha1.PluginConfigReady), "consumers", len(status.Consumers))
+	}
+	return ctrl.Result{RequeueAfter: r.resyncPeriod()}, nil
+}
+
+// SetupWithManager sets up the controller with the manager
+func (r *ClusterPluginConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	mapper := &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(obj handler.MapObject) []reconcile.Request {
+			return r.referencedPluginConfigs(obj, configv1alpha1.ClusterPluginConfigKind)
+		}),
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&configv1alpha1.ClusterPluginConfig{}).
+		Watches(&source.Kind{Type: &configv1alpha1.RepoConfig{}}, mapper).
+		Watches(&source.Kind{Type: &configv1alpha1.OrgConfig{}}, mapper).
+		Complete(r)
+}
+
+// referencedPluginConfigs returns the requests of the configs of the given kind referenced by a RepoConfig or OrgConfig,
+// including the configs a RepoConfig inherits from its OrgConfig
+func (r *PluginConfigReconciler) referencedPluginConfigs(obj handler.MapObject, kind string) []reconcile.Request {
+	var refs []configv1alpha1.PluginConfigRef
+	switch o := obj.Object.(type) {
+	case *configv1alpha1.RepoConfig:
+		var orgConfig *configv1alpha1.OrgConfig
+		if o.Spec.OrgConfig != "" {
+			orgConfig = &configv1alpha1.OrgConfig{}
+			if err := r.Get(context.Background(), types.NamespacedName{Namespace: o.Namespace, Name: o.Spec.OrgConfig}, orgConfig); err != nil {
+				orgConfig = nil
+			}
+		}
+		refs = effectivePluginConfigRefs(o, orgConfig)
+	case *configv1alpha1.OrgConfig:
+		refs = o.Spec.PluginConfig.AllRefs()
+	default:
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, ref := range refs {
+		if ref.RefKind() == kind {
+			requests = append(requests, reconcile.Request{NamespacedName: ref.Key(obj.Meta.GetNamespace())})
+		}
+	}
+	return requests
+}
+
+func (r *PluginConfigReconciler) status(ctx context.Context, kind string, meta *metav1.ObjectMeta, spec *configv1alpha1.PluginConfigSpec, current *configv1alpha1.PluginConfigStatus) (*configv1alpha1.PluginConfigStatus, error) {
+	key := types.NamespacedName{Namespace: meta.Namespace, Name: meta.Name}
+	consumers, err := r.consumers(ctx, kind, key)
+	if err != nil {
+		return nil, err
+	}
+	status := current.DeepCopy()
+	status.Consumers = consumers
+	defaulted := spec.DeepCopy()
+	defaulted.Default()
+	configv1alpha1.SetCondition(&status.Conditions, validCondition(defaulted))
+	configv1alpha1.SetCondition(&status.Conditions, r.secretsCondition(ctx, key.Namespace, consumers, defaulted))
+	configv1alpha1.SetCondition(&status.Conditions, pluginConfigReadyCondition(status.Conditions))
+	status.ObservedGeneration = meta.Generation
+	return status, nil
+}
+
+// consumers returns the repo configs and org configs referencing the config of the given kind and key, sorted.
+// Repo configs inheriting their plugin config from their OrgConfig are consumers of the configs the OrgConfig references.
+func (r *PluginConfigReconciler) consumers(ctx context.Context, kind string, key types.NamespacedName) ([]configv1alpha1.PluginConfigConsumer, error) {
+	references := func(namespace string, refs []configv1alpha1.PluginConfigRef) bool {
+		for _, ref := range refs {
+			if ref.RefKind() == kind && ref.Key(namespace) == key {
+				return true
+			}
+		}
+		return false
+	}
+	var consumers []configv1alpha1.PluginConfigConsumer
+	var orgConfigs configv1alpha1.OrgConfigList
+	if err := r.List(ctx, &orgConfigs); err != nil {
+		return nil, fmt.Errorf("failed to list org configs: %w", err)
+	}
+	byKey := map[types.NamespacedName]*configv1alpha1.OrgConfig{}
+	for i := range orgConfigs.Items {
+		orgConfig := &orgConfigs.Items[i]
+		byKey[types.NamespacedName{Namespace: orgConfig.Namespace, Name: orgConfig.Name}] = orgConfig
+		if references(orgConfig.Namespace, orgConfig.Spec.PluginConfig.AllRefs()) {
+			consumers = append(consumers, configv1alpha1.PluginConfigConsumer{Kind: "OrgConfig", Namespace: orgConfig.Namespace, Name: orgConfig.Name})
+		}
+	}
+	var repoConfigs configv1alpha1.RepoConfigList
+	if err := r.List(ctx, &repoConfigs); err != nil {
+		return nil, fmt.Errorf("failed to list repo configs: %w", err)
+	}
+	for i := range repoConfigs.Items {
+		repoConfig := &repoConfigs.Items[i]
+		var orgConfig *configv1alpha1.OrgConfig
+		if repoConfig.Spec.OrgConfig != "" {
+			orgConfig = byKey[types.NamespacedName{Namespace: repoConfig.Namespace, Name: repoConfig.Spec.OrgConfig}]
+		}
+		if references(repoConfig.Namespace, effectivePluginConfigRefs(repoConfig, orgConfig)) {
+			consumers = append(consumers, configv1alpha1.PluginConfigConsumer{Kind: "RepoConfig", Namespace: repoConfig.Namespace, Name: repoConfig.Name})
+		}
+	}
+	sort.Slice(consumers, func(i, j int) bool {
+		a, b := consumers[i], consumers[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Name < b.Name
+	})
+	return consumers, nil
+}
+
+// effectivePluginConfigRefs returns the plugin configs referenced by the effective spec of a repo config,
+// orgConfig is the OrgConfig the repo config references, nil when it has none or it is not found.
+// The repo config own refs are returned when the OrgConfig is missing or does not apply, the repo config controller reports it.
+func effectivePluginConfigRefs(repoConfig *configv1alpha1.RepoConfig, orgConfig *configv1alpha1.OrgConfig) []configv1alpha1.PluginConfigRef {
+	if orgConfig == nil || orgConfig.Spec.CheckRepo(&repoConfig.Spec) != nil {
+		return repoConfig.Spec.PluginConfig.AllRefs()
+	}
+	_, repo := repoConfig.Spec.Repository()
+	spec := orgConfig.Spec.ForRepo(repo)
+	spec = spec.Override(&repoConfig.Spec)
+	return spec.PluginConfig.AllRefs()
+}
+
+func validCondition(spec *configv1alpha1.PluginConfigSpec) configv1alpha1.Condition {
+	if errs := spec.Validate(field.NewPath("spec")); len(errs) != 0 {
+		return newCondition(configv1alpha1.PluginConfigValid, corev1.ConditionFalse, "Invalid", errs.ToAggregate().Error())
+	}
+	return newCondition(configv1alpha1.PluginConfigValid, corev1.ConditionTrue, "Valid", "plugin settings are valid")
+}
+
+// secretsCondition resolves the plugin secrets in the namespace of the config,
+// or in the namespaces of the consumers for cluster scoped configs.
+// Secret references of a cluster scoped config without consumers cannot be resolved and are reported as unknown,
+// only inline values resolve without a namespace.
+func (r *PluginConfigReconciler) secretsCondition(ctx context.Context, namespace string, consumers []configv1alpha1.PluginConfigConsumer, spec *configv1alpha1.PluginConfigSpec) configv1alpha1.Condition {
+	namespaces := []string{namespace}
+	if namespace == "" {
+		namespaces = nil
+		for _, consumer := range consumers {
+			if len(namespaces) == 0 || namespaces[len(namespaces)-1] != consumer.Namespace {
+				namespaces = append(namespaces, consumer.Namespace)
+			}
+		}
+	}
+	keys := []struct {
+		path   string
+		secret configv1alpha1.Secret
+	}{{"spec.cat.key", spec.Cat.Key}, {"spec.goose.key", spec.Goose.Key}}
+	var failures, unresolved []string
+	for _, key := range keys {
+		if key.secret.IsEmpty() {
+			continue
+		}
+		if key.secret.ValueFrom == nil {
+			if _, err := secrets.Get(ctx, r, namespace, key.secret); err != nil {
+				failures = append(failures, fmt.Sprintf("%s: %s", key.path, err))
+			}
+			continue
+		}
+		if len(namespaces) == 0 {
+			unresolved = append(unresolved, key.path)
+			continue
+		}
+		for _, ns := range namespaces {
+			if _, err := secrets.Get(ctx, r, ns, key.secret); err != nil {
+				failures = append(failures, fmt.Sprintf("%s: %s", key.path, err))
+			}
+		}
+	}
+	if len(failures) != 0 {
+		return newCondition(configv1alpha1.PluginConfigSecretsResolved, corev1.ConditionFalse, "SecretsFailed", strings.Join(failures, ", "))
+	}
+	if len(unresolved) != 0 {
+		return newCondition(configv1alpha1.PluginConfigSecretsResolved, corev1.ConditionUnknown, "NoConsumers", fmt.Sprintf("no consumer namespace to resolve in: %s", strings.Join(unresolved, ", ")))
+	}
+	return newCondition(configv1alpha1.PluginConfigSecretsResolved, corev1.ConditionTrue, "Resolved", "plugin secrets resolve")
+}
+
+func pluginConfigReadyCondition(conditions []configv1alpha1.Condition) configv1alpha1.Condition {
+	return aggregateCondition(configv1alpha1.PluginConfigReady, conditions, "plugin config is ready")
+}
+
+func (r *PluginConfigReconciler) resyncPeriod() time.Duration {
+	if r.ResyncPeriod <= 0 {
+		return DefaultResyncPeriod
+	}
+	return r.ResyncPeriod
+}
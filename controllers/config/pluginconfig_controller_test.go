@@ -0,0 +1,145 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	configv1alpha1 "github.com/kloops-io/kloops/apis/config/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestPluginConfigConsumers(t *testing.T) {
+	giteaRepo := func(repo string) *configv1alpha1.GiteaRepo {
+		return &configv1alpha1.GiteaRepo{Owner: "my-org", Repo: repo}
+	}
+	orgConfig := &configv1alpha1.OrgConfig{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "my-org"},
+		Spec: configv1alpha1.OrgConfigSpec{
+			Gitea:        &configv1alpha1.GiteaOrg{Owner: "my-org", ServerURL: "https://gitea.example.com"},
+			Exclude:      []string{"excluded"},
+			PluginConfig: configv1alpha1.RepoPluginConfig{Ref: "shared"},
+		},
+	}
+	repoConfigs := []*configv1alpha1.RepoConfig{{
+		// inherits the plugin config of the org config
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "inherits"},
+		Spec:       configv1alpha1.RepoConfigSpec{OrgConfig: "my-org", Gitea: giteaRepo("inherits"), PluginConfig: configv1alpha1.RepoPluginConfig{Plugins: []string{"cat"}}},
+	}, {
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "overrides"},
+		Spec:       configv1alpha1.RepoConfigSpec{OrgConfig: "my-org", Gitea: giteaRepo("overrides"), PluginConfig: configv1alpha1.RepoPluginConfig{Ref: "other"}},
+	}, {
+		// the org config does not apply, the repo config own refs are used
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "excluded"},
+		Spec:       configv1alpha1.RepoConfigSpec{OrgConfig: "my-org", Gitea: giteaRepo("excluded")},
+	}, {
+		ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "direct"},
+		Spec: configv1alpha1.RepoConfigSpec{
+			Gitea:        &configv1alpha1.GiteaRepo{Owner: "someone", Repo: "direct", ServerURL: "https://gitea.example.com"},
+			PluginConfig: configv1alpha1.RepoPluginConfig{Refs: []configv1alpha1.PluginConfigRef{{Name: "shared", Namespace: "ns"}}},
+		},
+	}}
+	c := fake.NewFakeClientWithScheme(newConfigScheme(t), orgConfig, repoConfigs[0], repoConfigs[1], repoConfigs[2], repoConfigs[3])
+	r := &PluginConfigReconciler{Client: c, Log: logf.NullLogger{}}
+
+	got, err := r.consumers(context.Background(), configv1alpha1.PluginConfigKind, types.NamespacedName{Namespace: "ns", Name: "shared"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []configv1alpha1.PluginConfigConsumer{
+		{Kind: "OrgConfig", Namespace: "ns", Name: "my-org"},
+		{Kind: "RepoConfig", Namespace: "ns", Name: "inherits"},
+		{Kind: "RepoConfig", Namespace: "other", Name: "direct"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("consumers() = %+v, want %+v", got, want)
+	}
+}
+
+func TestClusterPluginConfigSecretsCondition(t *testing.T) {
+	secretKeyRef := configv1alpha1.Secret{ValueFrom: &configv1alpha1.ValueFrom{SecretKeyRef: &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "cat"},
+		Key:                  "key",
+	}}}
+	externalRef := configv1alpha1.Secret{ValueFrom: &configv1alpha1.ValueFrom{ExternalRef: &configv1alpha1.ExternalRef{Provider: "vault", Path: "cat", Key: "key"}}}
+	consumer := func(namespace string) configv1alpha1.PluginConfigConsumer {
+		return configv1alpha1.PluginConfigConsumer{Kind: "RepoConfig", Namespace: namespace, Name: "repo"}
+	}
+	tests := []struct {
+		name        string
+		key         configv1alpha1.Secret
+		consumers   []configv1alpha1.PluginConfigConsumer
+		want        corev1.ConditionStatus
+		wantReason  string
+		wantMessage string
+	}{{
+		name:       "inline value",
+		key:        configv1alpha1.Secret{Value: "key"},
+		want:       corev1.ConditionTrue,
+		wantReason: "Resolved",
+	}, {
+		name:       "secret resolved in every consumer namespace",
+		key:        secretKeyRef,
+		consumers:  []configv1alpha1.PluginConfigConsumer{consumer("a"), consumer("a"), consumer("b")},
+		want:       corev1.ConditionTrue,
+		wantReason: "Resolved",
+	}, {
+		name:        "secret missing in a consumer namespace",
+		key:         secretKeyRef,
+		consumers:   []configv1alpha1.PluginConfigConsumer{consumer("a"), consumer("c")},
+		want:        corev1.ConditionFalse,
+		wantReason:  "SecretsFailed",
+		wantMessage: "c/cat",
+	}, {
+		name:       "secret without consumers",
+		key:        secretKeyRef,
+		want:       corev1.ConditionUnknown,
+		wantReason: "NoConsumers",
+	}, {
+		name:       "external secret without consumers",
+		key:        externalRef,
+		want:       corev1.ConditionUnknown,
+		wantReason: "NoConsumers",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := newConfigScheme(t)
+			if err := clientgoscheme.AddToScheme(scheme); err != nil {
+				t.Fatal(err)
+			}
+			var objs []runtime.Object
+			for _, namespace := range []string{"a", "b"} {
+				objs = append(objs, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "cat"}, Data: map[string][]byte{"key": []byte("value")}})
+			}
+			r := &PluginConfigReconciler{Client: fake.NewFakeClientWithScheme(scheme, objs...), Log: logf.NullLogger{}}
+			spec := &configv1alpha1.PluginConfigSpec{Cat: configv1alpha1.Cat{Key: tt.key}}
+			got := r.secretsCondition(context.Background(), "", tt.consumers, spec)
+			if got.Status != tt.want || got.Reason != tt.wantReason || !strings.Contains(got.Message, tt.wantMessage) {
+				t.Errorf("secretsCondition() = %+v, want %s %s containing %q", got, tt.want, tt.wantReason, tt.wantMessage)
+			}
+		})
+	}
+}
@@ -0,0 +1,423 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	configv1alpha1 "github.com/kloops-io/kloops/apis/config/v1alpha1"
+	"github.com/kloops-io/kloops/pkg/pluginconfig"
+	"github.com/kloops-io/kloops/pkg/plugins"
+	"github.com/kloops-io/kloops/pkg/repoconfig"
+	"github.com/kloops-io/kloops/pkg/scm"
+	"github.com/kloops-io/kloops/pkg/secrets"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// DefaultResyncPeriod is the default period at which repo configs are verified again
+const DefaultResyncPeriod = 10 * time.Minute
+
+// secretEventsBuffer is the number of secret change events buffered before notifications block
+const secretEventsBuffer = 100
+
+// WebhookFinalizer is the finalizer used to remove the repository webhooks when a RepoConfig or OrgConfig is deleted
+const WebhookFinalizer = "config.kloops.io/webhook"
+
+// RepoConfigReconciler reconciles a RepoConfig object
+type RepoConfigReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	// HookURL is the public url git servers send webhooks to.
+	// When set, the repository webhook is registered on the git server and removed when the RepoConfig is deleted.
+	HookURL string
+	// Recorder records events on repo configs, such as branch protection drift, events are not recorded when nil
+	Recorder record.EventRecorder
+	// Resolver notifies secret changes, when set the repo configs are requeued when their token or hmac token changes
+	Resolver *secrets.Resolver
+
+	secretEvents    chan event.GenericEvent
+	secretWatchesMu sync.Mutex
+	secretWatches   map[types.NamespacedName]*secretWatch
+	// ResyncPeriod is the period at which repo configs are verified again
+	ResyncPeriod time.Duration
+}
+
+// +kubebuilder:rbac:groups=config.kloops.io,resources=repoconfigs,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=config.kloops.io,resources=repoconfigs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=config.kloops.io,resources=pluginconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=config.kloops.io,resources=clusterpluginconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=config.kloops.io,resources=orgconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile registers the repository webhook, syncs the branch protection rules and labels, verifies a RepoConfig and records the results in its status conditions
+func (r *RepoConfigReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("repoconfig", req.NamespacedName)
+
+	var repoConfig configv1alpha1.RepoConfig
+	if err := r.Get(ctx, req.NamespacedName, &repoConfig); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.unwatchSecrets(req.NamespacedName)
+		}
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !repoConfig.DeletionTimestamp.IsZero() {
+		r.unwatchSecrets(req.NamespacedName)
+		return ctrl.Result{}, r.finalize(ctx, log, &repoConfig)
+	}
+	if r.HookURL != "" && !controllerutil.ContainsFinalizer(&repoConfig, WebhookFinalizer) {
+		controllerutil.AddFinalizer(&repoConfig, WebhookFinalizer)
+		if err := r.Update(ctx, &repoConfig); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	status := repoConfig.Status.DeepCopy()
+	effective, orgConfigCondition := r.effectiveRepoConfig(ctx, &repoConfig)
+	configv1alpha1.SetCondition(&status.Conditions, orgConfigCondition)
+	r.watchSecrets(ctx, log, effective)
+	scmClient, tokenCondition := r.verifyToken(ctx, effective)
+	configv1alpha1.SetCondition(&status.Conditions, tokenCondition)
+	configv1alpha1.SetCondition(&status.Conditions, r.reconcileWebhook(ctx, log, effective, scmClient, status))
+	configv1alpha1.SetCondition(&status.Conditions, r.syncBranchProtection(ctx, log, effective, scmClient, status))
+	configv1alpha1.SetCondition(&status.Conditions, r.syncLabels(ctx, log, effective, scmClient, status))
+	configv1alpha1.SetCondition(&status.Conditions, r.resolvePluginConfig(ctx, effective, status))
+	configv1alpha1.SetCondition(&status.Conditions, readyCondition(status.Conditions))
+	status.ObservedGeneration = repoConfig.Generation
+
+	if !equality.Semantic.DeepEqual(status, &repoConfig.Status) {
+		repoConfig.Status = *status
+		if err := r.Status().Update(ctx, &repoConfig); err != nil {
+			return ctrl.Result{}, err
+		}
+		log.Info("status updated", "ready", configv1alpha1.IsConditionTrue(status.Conditions, configv1alpha1.RepoConfigReady))
+	}
+	return ctrl.Result{RequeueAfter: r.resyncPeriod()}, nil
+}
+
+// SetupWithManager sets up the controller with the manager
+func (r *RepoConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	builder := ctrl.NewControllerManagedBy(mgr).
+		For(&configv1alpha1.RepoConfig{}).
+		Watches(&source.Kind{Type: &configv1alpha1.PluginConfig{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.repoConfigsForPluginConfig),
+		}).
+		Watches(&source.Kind{Type: &configv1alpha1.ClusterPluginConfig{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.repoConfigsForPluginConfig),
+		}).
+		Watches(&source.Kind{Type: &configv1alpha1.OrgConfig{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.repoConfigsForOrgConfig),
+		})
+	if r.Resolver != nil {
+		r.secretEvents = make(chan event.GenericEvent, secretEventsBuffer)
+		r.secretWatches = map[types.NamespacedName]*secretWatch{}
+		builder = builder.Watches(&source.Channel{Source: r.secretEvents}, &handler.EnqueueRequestForObject{})
+	}
+	return builder.Complete(r)
+}
+
+func (r *RepoConfigReconciler) repoConfigsForPluginConfig(obj handler.MapObject) []reconcile.Request {
+	kind := configv1alpha1.PluginConfigKind
+	if obj.Meta.GetNamespace() == "" {
+		kind = configv1alpha1.ClusterPluginConfigKind
+	}
+	// plugin configs can be referenced from any namespace
+	return r.repoConfigsMatching("", func(repoConfig *configv1alpha1.RepoConfig) bool {
+		for _, ref := range repoConfig.Spec.PluginConfig.AllRefs() {
+			if ref.RefKind() == kind && ref.Key(repoConfig.Namespace) == (types.NamespacedName{Namespace: obj.Meta.GetNamespace(), Name: obj.Meta.GetName()}) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (r *RepoConfigReconciler) repoConfigsForOrgConfig(obj handler.MapObject) []reconcile.Request {
+	return r.repoConfigsMatching(obj.Meta.GetNamespace(), func(repoConfig *configv1alpha1.RepoConfig) bool {
+		return repoConfig.Spec.OrgConfig == obj.Meta.GetName()
+	})
+}
+
+func (r *RepoConfigReconciler) repoConfigsMatching(namespace string, match func(*configv1alpha1.RepoConfig) bool) []reconcile.Request {
+	var list configv1alpha1.RepoConfigList
+	if err := r.List(context.Background(), &list, client.InNamespace(namespace)); err != nil {
+		r.Log.Error(err, "failed to list repo configs", "namespace", namespace)
+		return nil
+	}
+	var requests []reconcile.Request
+	for i := range list.Items {
+		repoConfig := &list.Items[i]
+		if match(repoConfig) {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: repoConfig.Namespace, Name: repoConfig.Name}})
+		}
+	}
+	return requests
+}
+
+func (r *RepoConfigReconciler) resyncPeriod() time.Duration {
+	if r.ResyncPeriod <= 0 {
+		return DefaultResyncPeriod
+	}
+	return r.ResyncPeriod
+}
+
+// effectiveRepoConfig returns a copy of the repo config with the settings inherited from its org config
+func (r *RepoConfigReconciler) effectiveRepoConfig(ctx context.Context, repoConfig *configv1alpha1.RepoConfig) (*configv1alpha1.RepoConfig, configv1alpha1.Condition) {
+	if repoConfig.Spec.OrgConfig == "" {
+		return repoConfig, newCondition(configv1alpha1.RepoConfigOrgConfigResolved, corev1.ConditionTrue, "NoReference", "no org config reference")
+	}
+	spec, err := repoconfig.Effective(ctx, r, repoConfig)
+	if err != nil {
+		if apierrors.IsNotFound(errors.Unwrap(err)) {
+			return repoConfig, newCondition(configv1alpha1.RepoConfigOrgConfigResolved, corev1.ConditionFalse, "NotFound", fmt.Sprintf("org config %s not found", repoConfig.Spec.OrgConfig))
+		}
+		if errors.Is(err, repoconfig.ErrNotApplicable) {
+			return repoConfig, newCondition(configv1alpha1.RepoConfigOrgConfigResolved, corev1.ConditionFalse, "NotApplicable", err.Error())
+		}
+		return repoConfig, newCondition(configv1alpha1.RepoConfigOrgConfigResolved, corev1.ConditionUnknown, "GetFailed", err.Error())
+	}
+	effective := repoConfig.DeepCopy()
+	effective.Spec = *spec
+	return effective, newCondition(configv1alpha1.RepoConfigOrgConfigResolved, corev1.ConditionTrue, "Resolved", fmt.Sprintf("org config %s found", repoConfig.Spec.OrgConfig))
+}
+
+func (r *RepoConfigReconciler) verifyToken(ctx context.Context, repoConfig *configv1alpha1.RepoConfig) (scm.Client, configv1alpha1.Condition) {
+	scmClient, err := scm.NewClientFromSpec(ctx, r, repoConfig.Namespace, &repoConfig.Spec)
+	if errors.Is(err, scm.ErrNoProvider) {
+		return nil, newCondition(configv1alpha1.RepoConfigTokenValid, corev1.ConditionFalse, "NoGitServer", err.Error())
+	}
+	if err != nil {
+		return nil, newCondition(configv1alpha1.RepoConfigTokenValid, corev1.ConditionFalse, "SecretNotResolved", err.Error())
+	}
+	user, err := scmClient.CurrentUser(ctx)
+	if err != nil {
+		return nil, newCondition(configv1alpha1.RepoConfigTokenValid, corev1.ConditionFalse, "AuthenticationFailed", err.Error())
+	}
+	permissions, err := scmClient.Permissions(ctx)
+	if err != nil {
+		return nil, newCondition(configv1alpha1.RepoConfigTokenValid, corev1.ConditionUnknown, "PermissionsUnknown", err.Error())
+	}
+	if !permissions.Push {
+		return nil, newCondition(configv1alpha1.RepoConfigTokenValid, corev1.ConditionFalse, "InsufficientPermissions", fmt.Sprintf("user %s does not have write access to the repository", user))
+	}
+	return scmClient, newCondition(configv1alpha1.RepoConfigTokenValid, corev1.ConditionTrue, "Authenticated", fmt.Sprintf("authenticated as %s", user))
+}
+
+func (r *RepoConfigReconciler) reconcileWebhook(ctx context.Context, log logr.Logger, repoConfig *configv1alpha1.RepoConfig, scmClient scm.Client, status *configv1alpha1.RepoConfigStatus) configv1alpha1.Condition {
+	if r.HookURL == "" {
+		// webhooks are registered by hand, there is nothing to report
+		return newCondition(configv1alpha1.RepoConfigWebhookRegistered, corev1.ConditionTrue, "NotManaged", "the public hook url is not configured, webhooks are not registered by the controller")
+	}
+	if scmClient == nil {
+		return newCondition(configv1alpha1.RepoConfigWebhookRegistered, corev1.ConditionUnknown, "TokenInvalid", "webhooks cannot be registered without a valid token")
+	}
+	hmacTokenSecret, err := scm.HmacTokenSecret(&repoConfig.Spec)
+	if err != nil {
+		return newCondition(configv1alpha1.RepoConfigWebhookRegistered, corev1.ConditionFalse, "NoGitServer", err.Error())
+	}
+	hmacToken, err := secrets.Get(ctx, r, repoConfig.Namespace, hmacTokenSecret)
+	if err != nil {
+		return newCondition(configv1alpha1.RepoConfigWebhookRegistered, corev1.ConditionFalse, "SecretNotResolved", err.Error())
+	}
+	spec := scm.HookSpec{URL: r.HookURL, Secret: hmacToken}
+	hook, reason, err := ensureWebhook(ctx, log, scmClient, spec, status.WebhookID, status.WebhookSecretHash)
+	if err != nil {
+		conditionStatus := corev1.ConditionFalse
+		if reason == "ListFailed" {
+			conditionStatus = corev1.ConditionUnknown
+		}
+		return newCondition(configv1alpha1.RepoConfigWebhookRegistered, conditionStatus, reason, err.Error())
+	}
+	status.WebhookID = hook.ID
+	status.WebhookSecretHash = webhookSecretHash(spec)
+	if d := hook.LastDelivery; d != nil && d.Failed() {
+		return newCondition(configv1alpha1.RepoConfigWebhookRegistered, corev1.ConditionFalse, "DeliveryFailed", fmt.Sprintf("last delivery to webhook %d failed with code %d: %s", hook.ID, d.Code, d.Message))
+	}
+	return newCondition(configv1alpha1.RepoConfigWebhookRegistered, corev1.ConditionTrue, "Registered", fmt.Sprintf("webhook %d points at %s", hook.ID, r.HookURL))
+}
+
+// ensureWebhook creates the repository webhook, or updates it when its url, state or secret changed.
+// The webhook last registered by the controller is found by id, falling back to its url.
+// On failure, the returned reason is ListFailed, CreateFailed or UpdateFailed.
+func ensureWebhook(ctx context.Context, log logr.Logger, scmClient scm.Client, spec scm.HookSpec, webhookID int64, secretHash string) (*scm.Hook, string, error) {
+	hooks, err := scmClient.ListHooks(ctx)
+	if err != nil {
+		return nil, "ListFailed", err
+	}
+	hook := findRegisteredHook(hooks, webhookID, spec.URL)
+	switch {
+	case hook == nil:
+		created, err := scmClient.CreateHook(ctx, spec)
+		if err != nil {
+			return nil, "CreateFailed", err
+		}
+		log.Info("webhook created", "id", created.ID, "url", created.URL)
+		hook = &created
+	// git servers do not return hook secrets, a rotated secret is detected with the hash of the last pushed one
+	case hook.URL != spec.URL || !hook.Active || secretHash != webhookSecretHash(spec):
+		updated, err := scmClient.UpdateHook(ctx, hook.ID, spec)
+		if err != nil {
+			return nil, "UpdateFailed", err
+		}
+		log.Info("webhook updated", "id", updated.ID, "url", updated.URL)
+		hook = &updated
+	}
+	return hook, "", nil
+}
+
+// webhookSecretHash returns the hex encoded sha256 hash of the hook url and secret
+func webhookSecretHash(hook scm.HookSpec) string {
+	sum := sha256.Sum256([]byte(hook.URL + "\n" + hook.Secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func (r *RepoConfigReconciler) finalize(ctx context.Context, log logr.Logger, repoConfig *configv1alpha1.RepoConfig) error {
+	if !controllerutil.ContainsFinalizer(repoConfig, WebhookFinalizer) {
+		return nil
+	}
+	if err := r.deleteWebhook(ctx, log, repoConfig); err != nil {
+		return err
+	}
+	controllerutil.RemoveFinalizer(repoConfig, WebhookFinalizer)
+	return r.Update(ctx, repoConfig)
+}
+
+func (r *RepoConfigReconciler) deleteWebhook(ctx context.Context, log logr.Logger, repoConfig *configv1alpha1.RepoConfig) error {
+	repoConfig, _ = r.effectiveRepoConfig(ctx, repoConfig)
+	scmClient, err := scm.NewClientFromSpec(ctx, r, repoConfig.Namespace, &repoConfig.Spec)
+	if errors.Is(err, scm.ErrNoProvider) {
+		return nil
+	}
+	if err != nil {
+		// without credentials the webhook cannot be removed, do not block the deletion forever
+		log.Error(err, "failed to resolve credentials, webhook will not be removed")
+		return nil
+	}
+	return removeWebhook(ctx, log, scmClient, repoConfig.Status.WebhookID, r.HookURL)
+}
+
+// removeWebhook deletes the repository webhook last registered by the controller, if any
+func removeWebhook(ctx context.Context, log logr.Logger, scmClient scm.Client, webhookID int64, url string) error {
+	hooks, err := scmClient.ListHooks(ctx)
+	if err != nil {
+		if scm.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	hook := findRegisteredHook(hooks, webhookID, url)
+	if hook == nil {
+		return nil
+	}
+	if err := scmClient.DeleteHook(ctx, hook.ID); err != nil && !scm.IsNotFound(err) {
+		return err
+	}
+	log.Info("webhook deleted", "id", hook.ID, "url", hook.URL)
+	return nil
+}
+
+func findRegisteredHook(hooks []scm.Hook, id int64, url string) *scm.Hook {
+	if id != 0 {
+		for i := range hooks {
+			if hooks[i].ID == id {
+				return &hooks[i]
+			}
+		}
+	}
+	return scm.FindHook(hooks, url)
+}
+
+func (r *RepoConfigReconciler) resolvePluginConfig(ctx context.Context, repoConfig *configv1alpha1.RepoConfig, status *configv1alpha1.RepoConfigStatus) configv1alpha1.Condition {
+	spec, err := pluginconfig.Effective(ctx, r, repoConfig.Namespace, &repoConfig.Spec.PluginConfig)
+	if err != nil {
+		// the last resolved plugin config is kept in the status
+		switch {
+		case errors.Is(err, pluginconfig.ErrNotFound):
+			return newCondition(configv1alpha1.RepoConfigPluginConfigResolved, corev1.ConditionFalse, "NotFound", err.Error())
+		case errors.Is(err, pluginconfig.ErrForbidden):
+			return newCondition(configv1alpha1.RepoConfigPluginConfigResolved, corev1.ConditionFalse, "Forbidden", err.Error())
+		}
+		return newCondition(configv1alpha1.RepoConfigPluginConfigResolved, corev1.ConditionUnknown, "GetFailed", err.Error())
+	}
+	// the status is shown to users and tools that must not see inline secret values
+	configv1alpha1.RedactSecrets(spec)
+	status.PluginConfig = spec
+	status.Plugins = plugins.Enabled(&repoConfig.Spec.PluginConfig)
+	refs := repoConfig.Spec.PluginConfig.AllRefs()
+	if len(refs) == 0 {
+		return newCondition(configv1alpha1.RepoConfigPluginConfigResolved, corev1.ConditionTrue, "NoReference", "no plugin config reference")
+	}
+	return newCondition(configv1alpha1.RepoConfigPluginConfigResolved, corev1.ConditionTrue, "Resolved", fmt.Sprintf("%d plugin config(s) merged", len(refs)))
+}
+
+func readyCondition(conditions []configv1alpha1.Condition) configv1alpha1.Condition {
+	return aggregateCondition(configv1alpha1.RepoConfigReady, conditions, "repo config is ready")
+}
+
+// aggregateCondition returns a condition of the given type that is false when any other condition is false,
+// unknown when any other condition is unknown and true otherwise
+func aggregateCondition(conditionType configv1alpha1.ConditionType, conditions []configv1alpha1.Condition, readyMessage string) configv1alpha1.Condition {
+	var unknown *configv1alpha1.Condition
+	for i, condition := range conditions {
+		if condition.Type == conditionType {
+			continue
+		}
+		switch condition.Status {
+		case corev1.ConditionFalse:
+			return newCondition(conditionType, corev1.ConditionFalse, string(condition.Type)+"False", condition.Message)
+		case corev1.ConditionUnknown:
+			if unknown == nil {
+				unknown = &conditions[i]
+			}
+		}
+	}
+	if unknown != nil {
+		return newCondition(conditionType, corev1.ConditionUnknown, string(unknown.Type)+"Unknown", unknown.Message)
+	}
+	return newCondition(conditionType, corev1.ConditionTrue, "Ready", readyMessage)
+}
+
+func newCondition(conditionType configv1alpha1.ConditionType, status corev1.ConditionStatus, reason, message string) configv1alpha1.Condition {
+	return configv1alpha1.Condition{
+		Type:    conditionType,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	}
+}
@@ -0,0 +1,83 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	configv1alpha1 "github.com/kloops-io/kloops/apis/config/v1alpha1"
+	"github.com/kloops-io/kloops/pkg/scm"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+// secretWatch holds the secret subscriptions of a repo config
+type secretWatch struct {
+	secrets []configv1alpha1.Secret
+	cancel  []func()
+}
+
+// watchSecrets subscribes to the token, hmac token and app private key secrets of the effective repo config,
+// a change of their values requeues the repo config. Subscriptions are only renewed when the secrets change.
+func (r *RepoConfigReconciler) watchSecrets(ctx context.Context, log logr.Logger, repoConfig *configv1alpha1.RepoConfig) {
+	if r.Resolver == nil {
+		return
+	}
+	var watched []configv1alpha1.Secret
+	for _, get := range []func(*configv1alpha1.RepoConfigSpec) (configv1alpha1.Secret, error){scm.TokenSecret, scm.HmacTokenSecret, scm.AppPrivateKeySecret} {
+		if secret, err := get(&repoConfig.Spec); err == nil {
+			watched = append(watched, secret)
+		}
+	}
+	key := types.NamespacedName{Namespace: repoConfig.Namespace, Name: repoConfig.Name}
+	r.secretWatchesMu.Lock()
+	defer r.secretWatchesMu.Unlock()
+	if w, ok := r.secretWatches[key]; ok {
+		if reflect.DeepEqual(w.secrets, watched) {
+			return
+		}
+		w.stop()
+	}
+	w := &secretWatch{secrets: watched}
+	for _, secret := range watched {
+		// resolution errors are reported by the conditions, the subscription stays active
+		_, cancel, _ := r.Resolver.Subscribe(ctx, repoConfig.Namespace, secret, func(string, error) {
+			log.Info("secret changed, requeueing")
+			r.secretEvents <- event.GenericEvent{Meta: repoConfig, Object: repoConfig}
+		})
+		w.cancel = append(w.cancel, cancel)
+	}
+	r.secretWatches[key] = w
+}
+
+// unwatchSecrets cancels the secret subscriptions of a repo config
+func (r *RepoConfigReconciler) unwatchSecrets(key types.NamespacedName) {
+	r.secretWatchesMu.Lock()
+	defer r.secretWatchesMu.Unlock()
+	if w, ok := r.secretWatches[key]; ok {
+		w.stop()
+		delete(r.secretWatches, key)
+	}
+}
+
+func (w *secretWatch) stop() {
+	for _, cancel := range w.cancel {
+		cancel()
+	}
+}
@@ -0,0 +1,129 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package migration migrates custom resources to the storage version of their API.
+//
+// When a new API version becomes the storage version, objects already persisted keep being stored in the
+// previous version until they are written again, and the previous version cannot be removed from the CRD
+// as long as it is listed in the CRD stored versions. Upgrading a storage version goes as follows:
+//
+//  1. release the new version as hub and storage version, the previous version is still served and
+//     converted by the conversion webhook
+//  2. the StorageVersionMigrator rewrites all the objects when the manager starts and drops the previous
+//     version from the CRD stored versions
+//  3. in a later release, once every cluster ran the migrator, the previous version can stop being served
+//     and then be removed
+package migration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// crdGVK is the kind of custom resource definitions
+var crdGVK = schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"}
+
+// StorageVersionMigrator rewrites custom resources in their storage version and records the storage version
+// as the only stored version of their CRD, it runs once when the manager starts.
+type StorageVersionMigrator struct {
+	Client     client.Client
+	Log        logr.Logger
+	Scheme     *runtime.Scheme
+	RESTMapper meta.RESTMapper
+	// Lists are the list types of the kinds to migrate, in the storage version of their API
+	Lists []runtime.Object
+}
+
+// +kubebuilder:rbac:groups=config.kloops.io,resources=repoconfigs;pluginconfigs;clusterpluginconfigs;orgconfigs,verbs=list;update
+// +kubebuilder:rbac:groups=build.kloops.io,resources=jobs;mergerecords,verbs=list;update
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions/status,verbs=get;update;patch
+
+// SetupWithManager registers the migrator with the manager
+func (m *StorageVersionMigrator) SetupWithManager(mgr ctrl.Manager) error {
+	return mgr.Add(m)
+}
+
+// Start implements manager.Runnable
+func (m *StorageVersionMigrator) Start(stop <-chan struct{}) error {
+	ctx := context.Background()
+	for _, list := range m.Lists {
+		if err := m.migrate(ctx, list); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *StorageVersionMigrator) migrate(ctx context.Context, list runtime.Object) error {
+	gvk, err := apiutil.GVKForObject(list, m.Scheme)
+	if err != nil {
+		return err
+	}
+	gk := schema.GroupKind{Group: gvk.Group, Kind: strings.TrimSuffix(gvk.Kind, "List")}
+	log := m.Log.WithValues("kind", gk, "version", gvk.Version)
+	mapping, err := m.RESTMapper.RESTMapping(gk, gvk.Version)
+	if err != nil {
+		return err
+	}
+	if err := m.Client.List(ctx, list); err != nil {
+		return fmt.Errorf("failed to list %s: %w", gk, err)
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		// a no-op update makes the api server store the object in the storage version,
+		// objects deleted or updated in the meantime don't need to be migrated anymore
+		if err := m.Client.Update(ctx, item); err != nil && !apierrors.IsNotFound(err) && !apierrors.IsConflict(err) {
+			return fmt.Errorf("failed to migrate %s: %w", gk, err)
+		}
+	}
+	log.Info("objects migrated", "count", len(items))
+	var crd unstructured.Unstructured
+	crd.SetGroupVersionKind(crdGVK)
+	if err := m.Client.Get(ctx, client.ObjectKey{Name: mapping.Resource.Resource + "." + gk.Group}, &crd); err != nil {
+		return fmt.Errorf("failed to get %s custom resource definition: %w", gk, err)
+	}
+	storedVersions, _, err := unstructured.NestedStringSlice(crd.Object, "status", "storedVersions")
+	if err != nil {
+		return err
+	}
+	if len(storedVersions) == 1 && storedVersions[0] == gvk.Version {
+		return nil
+	}
+	patch := client.MergeFrom(crd.DeepCopy())
+	if err := unstructured.SetNestedStringSlice(crd.Object, []string{gvk.Version}, "status", "storedVersions"); err != nil {
+		return err
+	}
+	if err := m.Client.Status().Patch(ctx, &crd, patch); err != nil {
+		return fmt.Errorf("failed to update %s stored versions: %w", gk, err)
+	}
+	log.Info("stored versions updated", "previous", storedVersions)
+	return nil
+}
@@ -0,0 +1,39 @@
+#!/bin/bash
+
+# Generates the typed clientset, listers and informers of the KLoops API groups in pkg/client.
+# client-gen, lister-gen and informer-gen (k8s.io/code-generator) must be in the PATH.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+ROOT_DIR=$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)
+MODULE=github.com/kloops-io/kloops
+APIS_PKG=${MODULE}/apis
+OUTPUT_PKG=${MODULE}/pkg/client
+GROUPS_WITH_VERSIONS="config/v1alpha1,config/v1beta1,build/v1alpha1,build/v1beta1"
+INPUT_DIRS=${APIS_PKG}/config/v1alpha1,${APIS_PKG}/config/v1beta1,${APIS_PKG}/build/v1alpha1,${APIS_PKG}/build/v1beta1
+HEADER=${ROOT_DIR}/hack/boilerplate.go.txt
+
+OUTPUT_BASE=$(mktemp -d)
+trap 'rm -rf "${OUTPUT_BASE}"' EXIT
+
+cd "${ROOT_DIR}"
+
+client-gen --clientset-name versioned \
+    --input-base "${APIS_PKG}" --input "${GROUPS_WITH_VERSIONS}" \
+    --output-package "${OUTPUT_PKG}/clientset" \
+    --go-header-file "${HEADER}" --output-base "${OUTPUT_BASE}"
+
+lister-gen --input-dirs "${INPUT_DIRS}" \
+    --output-package "${OUTPUT_PKG}/listers" \
+    --go-header-file "${HEADER}" --output-base "${OUTPUT_BASE}"
+
+informer-gen --input-dirs "${INPUT_DIRS}" \
+    --versioned-clientset-package "${OUTPUT_PKG}/clientset/versioned" \
+    --listers-package "${OUTPUT_PKG}/listers" \
+    --output-package "${OUTPUT_PKG}/informers" \
+    --go-header-file "${HEADER}" --output-base "${OUTPUT_BASE}"
+
+rm -rf pkg/client
+cp -r "${OUTPUT_BASE}/${OUTPUT_PKG}" pkg/client
@@ -0,0 +1,328 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package automerge merges the pull requests matching the auto merge criteria of a repository.
+//
+// Eligible pull requests are queued by priority then age, the head batch of the queue is merged and the other
+// queued pull requests targeting the same branch are updated with it. Pull requests with merge conflicts get the needs rebase label and comment.
+// In dry run mode the merges, branch updates and needs rebase changes are only logged, and merges are recorded as dry run.
+package automerge
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	buildv1alpha1 "github.com/kloops-io/kloops/apis/build/v1alpha1"
+	configv1alpha1 "github.com/kloops-io/kloops/apis/config/v1alpha1"
+	"github.com/kloops-io/kloops/pkg/mergerecord"
+	"github.com/kloops-io/kloops/pkg/scm"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// StatusContext is the commit status context reporting the merge queue position of pull requests
+const StatusContext = "kloops/merge-queue"
+
+// ApprovedLabel is the label added by the approve plugin, it is required on pull requests when reviews must be approved
+const ApprovedLabel = "approved"
+
+// Merger merges pull requests and records the merges
+type Merger struct {
+	Client client.Client
+	Log    logr.Logger
+}
+
+// +kubebuilder:rbac:groups=build.kloops.io,resources=mergerecords,verbs=get;list;watch;create;delete
+
+// HandleWebhook adds or removes the needs rebase label when a pull request is opened or updated.
+// spec is the effective spec of the repo config.
+func (m *Merger) HandleWebhook(ctx context.Context, repoConfig *configv1alpha1.RepoConfig, spec *configv1alpha1.RepoConfigSpec, scmClient scm.Client, hook *scm.Webhook) error {
+	config := spec.AutoMerge
+	if config == nil || config.NeedsRebase == nil || hook.Kind != scm.WebhookPullRequest {
+		return nil
+	}
+	if hook.Action != scm.ActionOpened && hook.Action != scm.ActionSynchronize && hook.Action != scm.ActionReopened {
+		return nil
+	}
+	// webhook payloads do not tell whether the pull request has merge conflicts
+	pr, err := scmClient.GetPullRequest(ctx, hook.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get pull request %d: %w", hook.Number, err)
+	}
+	return m.syncNeedsRebase(ctx, m.logger(repoConfig), config, scmClient, pr)
+}
+
+// Sync evaluates the given pull requests of the repository and queues the eligible ones by priority then age.
+// The head batch of the queue is merged and the branches of the other queued pull requests targeting
+// the same branch are updated, the queue position of each pull request is reported in a commit status.
+// spec is the effective spec of the repo config.
+func (m *Merger) Sync(ctx context.Context, repoConfig *configv1alpha1.RepoConfig, spec *configv1alpha1.RepoConfigSpec, scmClient scm.Client, numbers []int) error {
+	config := spec.AutoMerge
+	if config == nil {
+		return nil
+	}
+	log := m.logger(repoConfig)
+	var queue []*scm.PullRequest
+	for _, number := range numbers {
+		pr, err := scmClient.GetPullRequest(ctx, number)
+		if err != nil {
+			return fmt.Errorf("failed to get pull request %d: %w", number, err)
+		}
+		if pr.Closed {
+			continue
+		}
+		if config.NeedsRebase != nil {
+			if err := m.syncNeedsRebase(ctx, log, config, scmClient, pr); err != nil {
+				return err
+			}
+		}
+		if !eligible(config, pr) {
+			continue
+		}
+		if _, err := config.MergeTypeFor(pr.BaseRef, pr.Labels); err != nil {
+			log.Info("pull request not queued", "number", pr.Number, "reason", err.Error())
+			continue
+		}
+		queue = append(queue, pr)
+	}
+	if len(queue) == 0 {
+		return nil
+	}
+	sort.SliceStable(queue, func(i, j int) bool {
+		return config.Before(queue[i].Labels, queue[i].Created, queue[j].Labels, queue[j].Created)
+	})
+	for i, pr := range queue {
+		status := scm.Status{
+			State:       scm.StatusPending,
+			Context:     StatusContext,
+			Description: config.QueueDescription(pr.Labels, i, len(queue)),
+		}
+		if err := scmClient.CreateStatus(ctx, pr.HeadSHA, status); err != nil {
+			return fmt.Errorf("failed to set merge queue status of pull request %d: %w", pr.Number, err)
+		}
+	}
+	batch := nextBatch(config, queue)
+	if err := m.merge(ctx, log, repoConfig, spec, scmClient, batch); err != nil {
+		return err
+	}
+	if config.UpdateBranch == nil {
+		return nil
+	}
+	// the base branch moved, the other pull requests must be tested again against it
+	for _, pr := range queue[len(batch):] {
+		if pr.BaseRef != batch[0].BaseRef {
+			continue
+		}
+		if config.DryRun {
+			log.Info("would update pull request branch", "number", pr.Number, "method", config.UpdateBranch.Method)
+			continue
+		}
+		if err := scmClient.UpdateBranch(ctx, pr.Number, config.UpdateBranch.Method); err != nil {
+			log.Error(err, "failed to update pull request branch", "number", pr.Number)
+			continue
+		}
+		log.Info("pull request branch updated", "number", pr.Number, "method", config.UpdateBranch.Method)
+	}
+	return nil
+}
+
+// nextBatch returns the pull requests at the head of the queue that target the same branch with the same merge type,
+// up to the batch size limit. Only the head pull request is returned when batch merging is disabled.
+func nextBatch(config *configv1alpha1.AutoMerge, queue []*scm.PullRequest) []*scm.PullRequest {
+	head := queue[0]
+	mergeType, _ := config.MergeTypeFor(head.BaseRef, head.Labels)
+	batch := []*scm.PullRequest{head}
+	for _, pr := range queue[1:] {
+		if config.BatchSizeLimit == -1 || (config.BatchSizeLimit > 0 && len(batch) >= config.BatchSizeLimit) {
+			break
+		}
+		if t, _ := config.MergeTypeFor(pr.BaseRef, pr.Labels); pr.BaseRef != head.BaseRef || t != mergeType {
+			break
+		}
+		batch = append(batch, pr)
+	}
+	return batch
+}
+
+func (m *Merger) logger(repoConfig *configv1alpha1.RepoConfig) logr.Logger {
+	return m.Log.WithValues("repoconfig", types.NamespacedName{Namespace: repoConfig.Namespace, Name: repoConfig.Name})
+}
+
+// merge merges a batch of pull requests in order and records the merge.
+// The batch stops at the first pull request failing to merge, the pull requests merged before are still recorded.
+func (m *Merger) merge(ctx context.Context, log logr.Logger, repoConfig *configv1alpha1.RepoConfig, spec *configv1alpha1.RepoConfigSpec, scmClient scm.Client, batch []*scm.PullRequest) error {
+	config := spec.AutoMerge
+	mergeType, err := config.MergeTypeFor(batch[0].BaseRef, batch[0].Labels)
+	if err != nil {
+		return err
+	}
+	baseSHABefore, err := scmClient.GetBranchSHA(ctx, batch[0].BaseRef)
+	if err != nil {
+		return fmt.Errorf("failed to get the head of branch %s: %w", batch[0].BaseRef, err)
+	}
+	var merged []buildv1alpha1.MergedPullRequest
+	var jobs []buildv1alpha1.MergeJobResult
+	var mergeErr error
+	for _, pr := range batch {
+		// the statuses are read before merging, they are the results the pull request was merged on
+		prJobs, err := jobResults(ctx, scmClient, pr, len(batch) > 1)
+		if err != nil {
+			mergeErr = err
+			break
+		}
+		if mergeErr = m.mergePullRequest(ctx, log, config, scmClient, pr, mergeType); mergeErr != nil {
+			break
+		}
+		merged = append(merged, buildv1alpha1.MergedPullRequest{Number: pr.Number, Title: pr.Title, Author: pr.Author, HeadSHA: pr.HeadSHA})
+		jobs = append(jobs, prJobs...)
+	}
+	if len(merged) == 0 {
+		return mergeErr
+	}
+	var baseSHAAfter string
+	if !config.DryRun {
+		if baseSHAAfter, err = scmClient.GetBranchSHA(ctx, batch[0].BaseRef); err != nil {
+			log.Error(err, "failed to get the head of the merged branch", "branch", batch[0].BaseRef)
+		}
+	}
+	owner, repo := spec.Repository()
+	_, err = mergerecord.Create(ctx, m.Client, repoConfig, config.History, buildv1alpha1.MergeRecordSpec{
+		Repo:          owner + "/" + repo,
+		BaseRef:       batch[0].BaseRef,
+		BaseSHABefore: baseSHABefore,
+		BaseSHAAfter:  baseSHAAfter,
+		PullRequests:  merged,
+		MergeType:     mergeType,
+		Jobs:          jobs,
+		Criteria: buildv1alpha1.MergeCriteria{
+			Labels:                 config.Labels,
+			MissingLabels:          config.MissingLabels,
+			ReviewApprovedRequired: config.ReviewApprovedRequired,
+		},
+		MergedAt: metav1.Now(),
+		DryRun:   config.DryRun,
+	})
+	if mergeErr != nil {
+		return mergeErr
+	}
+	return err
+}
+
+// jobResults returns the results of the commit statuses of a pull request, except the merge queue status.
+// Job names are suffixed with the pull request number in batches.
+func jobResults(ctx context.Context, scmClient scm.Client, pr *scm.PullRequest, batch bool) ([]buildv1alpha1.MergeJobResult, error) {
+	statuses, err := scmClient.ListStatuses(ctx, pr.HeadSHA)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the statuses of pull request %d: %w", pr.Number, err)
+	}
+	var jobs []buildv1alpha1.MergeJobResult
+	for _, status := range statuses {
+		if status.Context == StatusContext {
+			continue
+		}
+		name := status.Context
+		if batch {
+			name = fmt.Sprintf("%s (#%d)", name, pr.Number)
+		}
+		jobs = append(jobs, buildv1alpha1.MergeJobResult{Name: name, State: string(status.State), URL: status.TargetURL})
+	}
+	return jobs, nil
+}
+
+// mergePullRequest merges a single pull request at its evaluated head sha
+func (m *Merger) mergePullRequest(ctx context.Context, log logr.Logger, config *configv1alpha1.AutoMerge, scmClient scm.Client, pr *scm.PullRequest, mergeType configv1alpha1.PullRequestMergeType) error {
+	options := scm.MergeOptions{Method: mergeType, SHA: pr.HeadSHA}
+	if mergeType == configv1alpha1.MergeSquash {
+		var err error
+		if options.CommitTitle, options.CommitMessage, err = config.MergeCommitTemplate.Render(pr); err != nil {
+			return fmt.Errorf("pull request %d: %w", pr.Number, err)
+		}
+	}
+	if config.DryRun {
+		log.Info("would merge pull request", "number", pr.Number, "mergeType", mergeType, "sha", pr.HeadSHA)
+		return nil
+	}
+	if err := scmClient.Merge(ctx, pr.Number, options); err != nil {
+		return fmt.Errorf("failed to merge pull request %d: %w", pr.Number, err)
+	}
+	log.Info("pull request merged", "number", pr.Number, "mergeType", mergeType)
+	return nil
+}
+
+// syncNeedsRebase adds the needs rebase label and comment to a pull request with merge conflicts,
+// and removes the label once the conflicts are resolved
+func (m *Merger) syncNeedsRebase(ctx context.Context, log logr.Logger, config *configv1alpha1.AutoMerge, scmClient scm.Client, pr *scm.PullRequest) error {
+	if pr.Mergeable == nil {
+		// the git server did not compute mergeability yet
+		return nil
+	}
+	label := config.NeedsRebase.GetLabel()
+	add, remove := config.NeedsRebase.LabelChanges(pr.Labels, !*pr.Mergeable)
+	switch {
+	case add:
+		comment, err := config.NeedsRebase.RenderComment(pr)
+		if err != nil {
+			return err
+		}
+		if config.DryRun {
+			log.Info("would add needs rebase label", "number", pr.Number, "comment", comment)
+			return nil
+		}
+		if err := scmClient.AddLabel(ctx, pr.Number, label); err != nil {
+			return fmt.Errorf("failed to add label %s to pull request %d: %w", label, pr.Number, err)
+		}
+		if err := scmClient.CreateComment(ctx, pr.Number, comment); err != nil {
+			return fmt.Errorf("failed to comment pull request %d: %w", pr.Number, err)
+		}
+		pr.Labels = append(pr.Labels, label)
+		log.Info("needs rebase label added", "number", pr.Number)
+	case remove:
+		if config.DryRun {
+			log.Info("would remove needs rebase label", "number", pr.Number)
+			return nil
+		}
+		if err := scmClient.RemoveLabel(ctx, pr.Number, label); err != nil {
+			return fmt.Errorf("failed to remove label %s from pull request %d: %w", label, pr.Number, err)
+		}
+		log.Info("needs rebase label removed", "number", pr.Number)
+	}
+	return nil
+}
+
+// eligible tells whether a pull request matches the auto merge criteria
+func eligible(config *configv1alpha1.AutoMerge, pr *scm.PullRequest) bool {
+	if pr.Mergeable != nil && !*pr.Mergeable {
+		return false
+	}
+	labels := map[string]bool{}
+	for _, label := range pr.Labels {
+		labels[label] = true
+	}
+	for _, label := range config.Labels {
+		if !labels[label] {
+			return false
+		}
+	}
+	for _, label := range config.MissingLabels {
+		if labels[label] {
+			return false
+		}
+	}
+	return !config.ReviewApprovedRequired || labels[ApprovedLabel]
+}
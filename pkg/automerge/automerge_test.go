@@ -0,0 +1,248 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package automerge
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	buildv1alpha1 "github.com/kloops-io/kloops/apis/build/v1alpha1"
+	configv1alpha1 "github.com/kloops-io/kloops/apis/config/v1alpha1"
+	"github.com/kloops-io/kloops/pkg/mergerecord"
+	"github.com/kloops-io/kloops/pkg/scm"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// fakeSCM is a fake git server client recording the changes made to pull requests,
+// operations not used by the merger are not implemented
+type fakeSCM struct {
+	scm.Client
+	prs      map[int]*scm.PullRequest
+	statuses map[string]string
+	merged   []int
+	updated  []int
+	labels   map[int][]string
+	comments map[int][]string
+	baseSHA  string
+}
+
+func newFakeSCM(prs ...*scm.PullRequest) *fakeSCM {
+	f := &fakeSCM{prs: map[int]*scm.PullRequest{}, statuses: map[string]string{}, labels: map[int][]string{}, comments: map[int][]string{}, baseSHA: "base0"}
+	for _, pr := range prs {
+		f.prs[pr.Number] = pr
+	}
+	return f
+}
+
+func (f *fakeSCM) GetPullRequest(ctx context.Context, number int) (*scm.PullRequest, error) {
+	pr, ok := f.prs[number]
+	if !ok {
+		return nil, fmt.Errorf("pull request %d not found", number)
+	}
+	copy := *pr
+	return &copy, nil
+}
+
+func (f *fakeSCM) CreateStatus(ctx context.Context, sha string, status scm.Status) error {
+	f.statuses[sha] = status.Description
+	return nil
+}
+
+func (f *fakeSCM) ListStatuses(ctx context.Context, ref string) ([]scm.Status, error) {
+	return []scm.Status{{Context: "ci", State: scm.StatusSuccess, TargetURL: "https://ci/" + ref}, {Context: StatusContext, State: scm.StatusPending}}, nil
+}
+
+func (f *fakeSCM) GetBranchSHA(ctx context.Context, branch string) (string, error) {
+	return f.baseSHA, nil
+}
+
+func (f *fakeSCM) Merge(ctx context.Context, number int, options scm.MergeOptions) error {
+	f.merged = append(f.merged, number)
+	f.baseSHA = fmt.Sprintf("base%d", len(f.merged))
+	return nil
+}
+
+func (f *fakeSCM) UpdateBranch(ctx context.Context, number int, method configv1alpha1.UpdateBranchMethod) error {
+	f.updated = append(f.updated, number)
+	return nil
+}
+
+func (f *fakeSCM) AddLabel(ctx context.Context, number int, label string) error {
+	f.labels[number] = append(f.labels[number], label)
+	return nil
+}
+
+func (f *fakeSCM) CreateComment(ctx context.Context, number int, body string) error {
+	f.comments[number] = append(f.comments[number], body)
+	return nil
+}
+
+var created = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func pr(number int, base string, age time.Duration, labels ...string) *scm.PullRequest {
+	return &scm.PullRequest{
+		Number:  number,
+		HeadSHA: fmt.Sprintf("sha%d", number),
+		BaseRef: base,
+		Labels:  append([]string{"lgtm"}, labels...),
+		Created: created.Add(-age),
+	}
+}
+
+func newMerger(t *testing.T) *Merger {
+	scheme := runtime.NewScheme()
+	if err := buildv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return &Merger{Client: fake.NewFakeClientWithScheme(scheme), Log: logf.NullLogger{}}
+}
+
+func sync(t *testing.T, m *Merger, config *configv1alpha1.AutoMerge, f *fakeSCM) {
+	repoConfig := &configv1alpha1.RepoConfig{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "repo"}}
+	spec := &configv1alpha1.RepoConfigSpec{GitHub: &configv1alpha1.GitHubRepo{Owner: "owner", Repo: "repo"}, AutoMerge: config}
+	var numbers []int
+	for number := range f.prs {
+		numbers = append(numbers, number)
+	}
+	if err := m.Sync(context.Background(), repoConfig, spec, f, numbers); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSyncQueue(t *testing.T) {
+	config := &configv1alpha1.AutoMerge{
+		MergeType:      configv1alpha1.MergeMerge,
+		Labels:         []string{"lgtm"},
+		PriorityLabels: []string{"critical", "high"},
+		BatchSizeLimit: -1,
+	}
+	f := newFakeSCM(
+		pr(1, "master", 3*time.Hour),
+		pr(2, "master", time.Hour, "high"),
+		pr(3, "master", 2*time.Hour, "critical"),
+		pr(4, "master", 4*time.Hour, "high"),
+		&scm.PullRequest{Number: 5, HeadSHA: "sha5", BaseRef: "master"},
+	)
+	sync(t, newMerger(t), config, f)
+
+	want := map[string]string{
+		"sha3": "In merge queue (1/4), priority critical",
+		"sha4": "In merge queue (2/4), priority high",
+		"sha2": "In merge queue (3/4), priority high",
+		"sha1": "In merge queue (4/4)",
+	}
+	if !reflect.DeepEqual(f.statuses, want) {
+		t.Errorf("statuses = %v, want %v", f.statuses, want)
+	}
+	if want := []int{3}; !reflect.DeepEqual(f.merged, want) {
+		t.Errorf("merged = %v, want %v", f.merged, want)
+	}
+}
+
+func TestSyncBatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		limit     int
+		wantMerge []int
+	}{{
+		name:      "unlimited",
+		limit:     0,
+		wantMerge: []int{1, 2, 3},
+	}, {
+		name:      "limited",
+		limit:     2,
+		wantMerge: []int{1, 2},
+	}, {
+		name:      "disabled",
+		limit:     -1,
+		wantMerge: []int{1},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &configv1alpha1.AutoMerge{
+				MergeType:      configv1alpha1.MergeMerge,
+				Labels:         []string{"lgtm"},
+				BatchSizeLimit: tt.limit,
+				UpdateBranch:   &configv1alpha1.UpdateBranch{Method: configv1alpha1.UpdateBranchMerge},
+			}
+			f := newFakeSCM(
+				pr(1, "master", 5*time.Hour),
+				pr(2, "master", 4*time.Hour),
+				pr(3, "master", 3*time.Hour),
+				// a different base branch ends the batch
+				pr(4, "release", 2*time.Hour),
+				pr(5, "master", time.Hour),
+			)
+			m := newMerger(t)
+			sync(t, m, config, f)
+			if !reflect.DeepEqual(f.merged, tt.wantMerge) {
+				t.Errorf("merged = %v, want %v", f.merged, tt.wantMerge)
+			}
+			// the queued pull requests targeting the merged branch are updated
+			var wantUpdated []int
+			for _, number := range []int{1, 2, 3, 5} {
+				if number > len(tt.wantMerge) {
+					wantUpdated = append(wantUpdated, number)
+				}
+			}
+			if !reflect.DeepEqual(f.updated, wantUpdated) {
+				t.Errorf("updated = %v, want %v", f.updated, wantUpdated)
+			}
+			records, err := mergerecord.List(context.Background(), m.Client, "ns", "repo")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(records) != 1 {
+				t.Fatalf("got %d merge records, want 1", len(records))
+			}
+			spec := records[0].Spec
+			if len(spec.PullRequests) != len(tt.wantMerge) {
+				t.Errorf("recorded %d pull requests, want %d", len(spec.PullRequests), len(tt.wantMerge))
+			}
+			if spec.BaseSHABefore != "base0" || spec.BaseSHAAfter != fmt.Sprintf("base%d", len(tt.wantMerge)) {
+				t.Errorf("recorded base shas %s..%s", spec.BaseSHABefore, spec.BaseSHAAfter)
+			}
+			if len(spec.Jobs) != len(tt.wantMerge) || spec.Jobs[0].State != string(scm.StatusSuccess) {
+				t.Errorf("recorded jobs %+v", spec.Jobs)
+			}
+		})
+	}
+}
+
+func TestSyncDryRun(t *testing.T) {
+	config := &configv1alpha1.AutoMerge{MergeType: configv1alpha1.MergeMerge, Labels: []string{"lgtm"}, DryRun: true}
+	f := newFakeSCM(pr(1, "master", time.Hour))
+	m := newMerger(t)
+	sync(t, m, config, f)
+	sync(t, m, config, f)
+	if len(f.merged) != 0 {
+		t.Errorf("dry run merged %v", f.merged)
+	}
+	records, err := mergerecord.List(context.Background(), m.Client, "ns", "repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || !records[0].Spec.DryRun {
+		t.Errorf("got %d merge records, want a single dry run record", len(records))
+	}
+}
@@ -0,0 +1,261 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package configstore keeps an in-memory snapshot of the effective repository configurations,
+// rebuilt from informers when RepoConfig, OrgConfig, PluginConfig or ClusterPluginConfig objects change.
+//
+// Snapshots are immutable and swapped atomically: an event handler acquires the current snapshot once
+// and uses it until it finishes, even if a newer snapshot is swapped in meanwhile.
+package configstore
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync/atomic"
+
+	"github.com/go-logr/logr"
+	"github.com/kloops-io/kloops/apis/config/v1alpha1"
+	"github.com/kloops-io/kloops/pkg/pluginconfig"
+	"github.com/kloops-io/kloops/pkg/plugins"
+	"github.com/kloops-io/kloops/pkg/repoconfig"
+	"k8s.io/apimachinery/pkg/runtime"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+)
+
+// Repo is the effective configuration of a repository
+type Repo struct {
+	// Namespace is the namespace of the RepoConfig or OrgConfig the configuration comes from
+	Namespace string
+	// Spec is the effective repo config spec
+	Spec *v1alpha1.RepoConfigSpec
+	// PluginConfig is the effective plugin config
+	PluginConfig *v1alpha1.PluginConfigSpec
+	// Plugins are the enabled plugins
+	Plugins []string
+}
+
+type org struct {
+	namespace    string
+	name         string
+	spec         *v1alpha1.OrgConfigSpec
+	pluginConfig *v1alpha1.PluginConfigSpec
+}
+
+// Snapshot is an immutable view of the effective repository configurations, it must not be modified
+type Snapshot struct {
+	// Generation is incremented every time a new snapshot is built
+	Generation int64
+	repos      map[string]*Repo
+	orgs       []org
+	// sources maps the repo configs to the repositories they configure, so that a repo config failing
+	// to resolve keeps the configuration it resolved to in the previous snapshot
+	sources map[string]source
+}
+
+// source is the repository a repo config configures
+type source struct {
+	key  string
+	repo *Repo
+}
+
+// Repo returns the effective configuration of a repository of the git server of the given kind and url.
+// A RepoConfig for the repository takes precedence, otherwise the first OrgConfig matching the repository configures it,
+// OrgConfigs configure the repositories they match without needing a RepoConfig.
+func (s *Snapshot) Repo(kind, serverURL, owner, repo string) (*Repo, bool) {
+	if r, ok := s.repos[repoKey(kind, serverURL, owner, repo)]; ok {
+		return r, true
+	}
+	for _, o := range s.orgs {
+		if k, u := o.spec.GitServer(); k == kind && u == serverURL && o.spec.Owner() == owner && o.spec.Matches(repo) {
+			spec := o.spec.ForRepo(repo)
+			return &Repo{Namespace: o.namespace, Spec: &spec, PluginConfig: o.pluginConfig, Plugins: plugins.Enabled(&spec.PluginConfig)}, true
+		}
+	}
+	return nil, false
+}
+
+// repoConfigKey identifies a repo config
+func repoConfigKey(repoConfig *v1alpha1.RepoConfig) string {
+	return repoConfig.Namespace + "/" + repoConfig.Name
+}
+
+// repoKey identifies a repository across git servers
+func repoKey(kind, serverURL, owner, repo string) string {
+	return kind + "|" + serverURL + "|" + owner + "/" + repo
+}
+
+// Store maintains the current snapshot
+type Store struct {
+	Log     logr.Logger
+	cache   cache.Cache
+	current atomic.Value
+	changes chan struct{}
+}
+
+// NewStore creates a store watching the config objects of the given cache.
+// The store must be started (it implements manager.Runnable) for snapshots to be built.
+func NewStore(ctx context.Context, c cache.Cache, log logr.Logger) (*Store, error) {
+	s := &Store{
+		Log:     log,
+		cache:   c,
+		changes: make(chan struct{}, 1),
+	}
+	s.current.Store(&Snapshot{repos: map[string]*Repo{}, sources: map[string]source{}})
+	handler := toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { s.changed() },
+		UpdateFunc: func(interface{}, interface{}) { s.changed() },
+		DeleteFunc: func(interface{}) { s.changed() },
+	}
+	for _, obj := range []runtime.Object{&v1alpha1.RepoConfig{}, &v1alpha1.OrgConfig{}, &v1alpha1.PluginConfig{}, &v1alpha1.ClusterPluginConfig{}} {
+		informer, err := c.GetInformer(ctx, obj)
+		if err != nil {
+			return nil, err
+		}
+		informer.AddEventHandler(handler)
+	}
+	return s, nil
+}
+
+// Snapshot returns the current snapshot
+func (s *Store) Snapshot() *Snapshot {
+	return s.current.Load().(*Snapshot)
+}
+
+// Acquire returns the current snapshot and a logger logging its generation, to be used for the whole handling of an event
+func (s *Store) Acquire(log logr.Logger) (*Snapshot, logr.Logger) {
+	snapshot := s.Snapshot()
+	return snapshot, log.WithValues("configGeneration", snapshot.Generation)
+}
+
+// Start builds a snapshot once the cache is synced and every time a config object changes, until stop is closed
+func (s *Store) Start(stop <-chan struct{}) error {
+	if !s.cache.WaitForCacheSync(stop) {
+		return errors.New("config cache failed to sync")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-stop
+		cancel()
+	}()
+	s.reload(ctx)
+	for {
+		select {
+		case <-s.changes:
+			s.reload(ctx)
+		case <-stop:
+			return nil
+		}
+	}
+}
+
+// changed schedules a reload, changes happening before the reload starts are coalesced
+func (s *Store) changed() {
+	select {
+	case s.changes <- struct{}{}:
+	default:
+	}
+}
+
+// reload builds and swaps a new snapshot.
+// Repositories whose configuration fails to resolve keep their configuration of the previous snapshot.
+func (s *Store) reload(ctx context.Context) {
+	previous := s.Snapshot()
+	snapshot := &Snapshot{Generation: previous.Generation + 1, repos: map[string]*Repo{}, sources: map[string]source{}}
+	log := s.Log.WithValues("configGeneration", snapshot.Generation)
+
+	var repoConfigs v1alpha1.RepoConfigList
+	if err := s.cache.List(ctx, &repoConfigs); err != nil {
+		log.Error(err, "failed to list repo configs, keeping the previous config")
+		return
+	}
+	// repositories configured in several namespaces use the first repo config by namespace and name
+	sort.Slice(repoConfigs.Items, func(i, j int) bool {
+		a, b := repoConfigs.Items[i], repoConfigs.Items[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	for i := range repoConfigs.Items {
+		repoConfig := &repoConfigs.Items[i]
+		repo, err := s.repo(ctx, repoConfig)
+		if err != nil {
+			log.Error(err, "failed to resolve repo config, keeping the previous config", "repoconfig", repoConfig.Namespace+"/"+repoConfig.Name)
+			if old, ok := previous.sources[repoConfigKey(repoConfig)]; ok {
+				if _, ok := snapshot.repos[old.key]; !ok {
+					snapshot.repos[old.key] = old.repo
+					snapshot.sources[repoConfigKey(repoConfig)] = old
+				}
+			}
+			continue
+		}
+		// repo configs inheriting from an org config have no server url of their own, the effective spec has
+		kind, serverURL := repo.Spec.GitServer()
+		owner, name := repo.Spec.Repository()
+		key := repoKey(kind, serverURL, owner, name)
+		if _, ok := snapshot.repos[key]; ok {
+			log.Info("repository already configured, skipping repo config", "repoconfig", repoConfig.Namespace+"/"+repoConfig.Name, "repository", owner+"/"+name, "server", serverURL)
+			continue
+		}
+		snapshot.repos[key] = repo
+		snapshot.sources[repoConfigKey(repoConfig)] = source{key: key, repo: repo}
+	}
+
+	var orgConfigs v1alpha1.OrgConfigList
+	if err := s.cache.List(ctx, &orgConfigs); err != nil {
+		log.Error(err, "failed to list org configs, keeping the previous config")
+		return
+	}
+	sort.Slice(orgConfigs.Items, func(i, j int) bool {
+		a, b := orgConfigs.Items[i], orgConfigs.Items[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	for i := range orgConfigs.Items {
+		orgConfig := &orgConfigs.Items[i]
+		pluginConfig, err := pluginconfig.Effective(ctx, s.cache, orgConfig.Namespace, &orgConfig.Spec.PluginConfig)
+		if err != nil {
+			log.Error(err, "failed to resolve org config plugin config, keeping the previous config", "orgconfig", orgConfig.Namespace+"/"+orgConfig.Name)
+			for _, o := range previous.orgs {
+				if o.namespace == orgConfig.Namespace && o.name == orgConfig.Name {
+					snapshot.orgs = append(snapshot.orgs, o)
+				}
+			}
+			continue
+		}
+		snapshot.orgs = append(snapshot.orgs, org{namespace: orgConfig.Namespace, name: orgConfig.Name, spec: &orgConfig.Spec, pluginConfig: pluginConfig})
+	}
+
+	s.current.Store(snapshot)
+	log.Info("config reloaded", "repos", len(snapshot.repos), "orgs", len(snapshot.orgs))
+}
+
+func (s *Store) repo(ctx context.Context, repoConfig *v1alpha1.RepoConfig) (*Repo, error) {
+	spec, err := repoconfig.Effective(ctx, s.cache, repoConfig)
+	if err != nil {
+		return nil, err
+	}
+	pluginConfig, err := pluginconfig.Effective(ctx, s.cache, repoConfig.Namespace, &spec.PluginConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &Repo{Namespace: repoConfig.Namespace, Spec: spec, PluginConfig: pluginConfig, Plugins: plugins.Enabled(&spec.PluginConfig)}, nil
+}
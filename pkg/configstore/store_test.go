@@ -0,0 +1,248 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configstore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/kloops-io/kloops/apis/config/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const serverURL = "https://gitea.example.com"
+
+// fakeCache serves the reads of the store from a fake client
+type fakeCache struct {
+	cache.Cache
+	client client.Client
+}
+
+func (c *fakeCache) Get(ctx context.Context, key types.NamespacedName, obj runtime.Object) error {
+	return c.client.Get(ctx, key, obj)
+}
+
+func (c *fakeCache) List(ctx context.Context, list runtime.Object, opts ...client.ListOption) error {
+	return c.client.List(ctx, list, opts...)
+}
+
+// recordingLogger records the logged lines with their values
+type recordingLogger struct {
+	values []interface{}
+	lines  *[]string
+}
+
+func (l recordingLogger) Info(msg string, keysAndValues ...interface{}) {
+	*l.lines = append(*l.lines, fmt.Sprint(msg, append(l.values, keysAndValues...)))
+}
+
+func (l recordingLogger) Enabled() bool { return true }
+
+func (l recordingLogger) Error(err error, msg string, keysAndValues ...interface{}) {
+	*l.lines = append(*l.lines, fmt.Sprint(msg, ": ", err, append(l.values, keysAndValues...)))
+}
+
+func (l recordingLogger) V(int) logr.InfoLogger { return l }
+
+func (l recordingLogger) WithValues(keysAndValues ...interface{}) logr.Logger {
+	return recordingLogger{values: append(append([]interface{}(nil), l.values...), keysAndValues...), lines: l.lines}
+}
+
+func (l recordingLogger) WithName(string) logr.Logger { return l }
+
+func newTestStore(t *testing.T, objs ...runtime.Object) (*Store, client.Client, *[]string) {
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	c := fake.NewFakeClientWithScheme(scheme, objs...)
+	lines := &[]string{}
+	s := &Store{Log: recordingLogger{lines: lines}, cache: &fakeCache{client: c}, changes: make(chan struct{}, 1)}
+	s.current.Store(&Snapshot{repos: map[string]*Repo{}, sources: map[string]source{}})
+	return s, c, lines
+}
+
+func orgConfig() *v1alpha1.OrgConfig {
+	return &v1alpha1.OrgConfig{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "my-org"},
+		Spec: v1alpha1.OrgConfigSpec{
+			Gitea:        &v1alpha1.GiteaOrg{Owner: "my-org", ServerURL: serverURL},
+			Include:      []string{"org-*"},
+			PluginConfig: v1alpha1.RepoPluginConfig{Ref: "shared"},
+		},
+	}
+}
+
+func pluginConfig(name string, threshold int) *v1alpha1.PluginConfig {
+	return &v1alpha1.PluginConfig{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name},
+		Spec:       v1alpha1.PluginConfigSpec{Size: v1alpha1.Size{S: threshold}},
+	}
+}
+
+// inheritingRepoConfig returns a repo config inheriting its server url from the org config,
+// it inherits the org config plugin config when pluginConfig is empty
+func inheritingRepoConfig(repo, pluginConfig string) *v1alpha1.RepoConfig {
+	return &v1alpha1.RepoConfig{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: repo},
+		Spec: v1alpha1.RepoConfigSpec{
+			OrgConfig:    "my-org",
+			Gitea:        &v1alpha1.GiteaRepo{Owner: "my-org", Repo: repo},
+			PluginConfig: v1alpha1.RepoPluginConfig{Ref: pluginConfig},
+		},
+	}
+}
+
+func TestReloadKeysInheritingRepoConfigsByServer(t *testing.T) {
+	s, _, _ := newTestStore(t, orgConfig(), pluginConfig("shared", 10), pluginConfig("own", 30), inheritingRepoConfig("org-repo", "own"))
+	s.reload(context.Background())
+
+	repo, ok := s.Snapshot().Repo("gitea", serverURL, "my-org", "org-repo")
+	if !ok {
+		t.Fatal("repository configured by an inheriting repo config not found")
+	}
+	if repo.PluginConfig.Size.S != 30 {
+		t.Errorf("Repo() size threshold = %d, want the repo config one", repo.PluginConfig.Size.S)
+	}
+	if _, ok := s.Snapshot().Repo("gitea", "", "my-org", "org-repo"); ok {
+		t.Error("repository keyed without its inherited server url")
+	}
+	if _, ok := s.Snapshot().Repo("gitea", serverURL, "my-org", "org-other"); !ok {
+		t.Error("repository matched by the org config only not found")
+	}
+	if _, ok := s.Snapshot().Repo("gitea", serverURL, "my-org", "other"); ok {
+		t.Error("repository not matched by the org config found")
+	}
+}
+
+func TestReloadSwapsSnapshots(t *testing.T) {
+	s, c, _ := newTestStore(t, orgConfig(), pluginConfig("shared", 10), inheritingRepoConfig("org-repo", ""))
+	ctx := context.Background()
+	s.reload(ctx)
+	acquired, _ := s.Acquire(s.Log)
+
+	if err := c.Update(ctx, pluginConfig("shared", 20)); err != nil {
+		// the fake client requires the resource version of the stored object
+		var current v1alpha1.PluginConfig
+		if err := c.Get(ctx, types.NamespacedName{Namespace: "ns", Name: "shared"}, &current); err != nil {
+			t.Fatal(err)
+		}
+		current.Spec.Size.S = 20
+		if err := c.Update(ctx, &current); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s.reload(ctx)
+
+	current := s.Snapshot()
+	if acquired == current || current.Generation != acquired.Generation+1 {
+		t.Fatalf("snapshot not swapped: generations %d and %d", acquired.Generation, current.Generation)
+	}
+	old, _ := acquired.Repo("gitea", serverURL, "my-org", "org-repo")
+	updated, _ := current.Repo("gitea", serverURL, "my-org", "org-repo")
+	if old.PluginConfig.Size.S != 10 || updated.PluginConfig.Size.S != 20 {
+		t.Errorf("size thresholds = %d (acquired) and %d (current), want 10 and 20", old.PluginConfig.Size.S, updated.PluginConfig.Size.S)
+	}
+}
+
+func TestReloadKeepsPreviousConfigOnFailure(t *testing.T) {
+	s, c, lines := newTestStore(t, orgConfig(), pluginConfig("shared", 10), pluginConfig("own", 30), inheritingRepoConfig("org-repo", "own"))
+	ctx := context.Background()
+	s.reload(ctx)
+
+	// the repo config no longer resolves, the org config still does
+	if err := c.Delete(ctx, pluginConfig("own", 30)); err != nil {
+		t.Fatal(err)
+	}
+	s.reload(ctx)
+	snapshot := s.Snapshot()
+	if snapshot.Generation != 2 {
+		t.Errorf("generation = %d, want 2", snapshot.Generation)
+	}
+	if repo, ok := snapshot.Repo("gitea", serverURL, "my-org", "org-repo"); !ok || repo.PluginConfig.Size.S != 30 {
+		t.Errorf("Repo() = %+v, %v, want the previous repo config", repo, ok)
+	}
+
+	// the org config no longer resolves either
+	if err := c.Delete(ctx, pluginConfig("shared", 10)); err != nil {
+		t.Fatal(err)
+	}
+	s.reload(ctx)
+	snapshot = s.Snapshot()
+	if repo, ok := snapshot.Repo("gitea", serverURL, "my-org", "org-repo"); !ok || repo.PluginConfig.Size.S != 30 {
+		t.Errorf("Repo() = %+v, %v, want the previous repo config", repo, ok)
+	}
+	if orgRepo, ok := snapshot.Repo("gitea", serverURL, "my-org", "org-other"); !ok || orgRepo.PluginConfig.Size.S != 10 {
+		t.Errorf("Repo() = %+v, %v, want the previous org config", orgRepo, ok)
+	}
+	if !containsLine(*lines, "failed to resolve repo config, keeping the previous config") {
+		t.Errorf("resolution failure not logged: %v", *lines)
+	}
+}
+
+func TestReloadDuplicateRepos(t *testing.T) {
+	// both repo configs configure the same repository, the first by namespace and name is used
+	direct := &v1alpha1.RepoConfig{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "z", Name: "org-repo"},
+		Spec:       v1alpha1.RepoConfigSpec{Gitea: &v1alpha1.GiteaRepo{Owner: "my-org", Repo: "org-repo", ServerURL: serverURL}},
+	}
+	s, _, lines := newTestStore(t, orgConfig(), pluginConfig("shared", 10), inheritingRepoConfig("org-repo", ""), direct)
+	s.reload(context.Background())
+
+	repo, ok := s.Snapshot().Repo("gitea", serverURL, "my-org", "org-repo")
+	if !ok || repo.Namespace != "ns" {
+		t.Errorf("Repo() = %+v, %v, want the repo config of namespace ns", repo, ok)
+	}
+	if !containsLine(*lines, "repository already configured, skipping repo config") || !containsLine(*lines, "z/org-repo") {
+		t.Errorf("skipped duplicate not logged: %v", *lines)
+	}
+}
+
+func TestAcquireLogsGeneration(t *testing.T) {
+	s, _, lines := newTestStore(t)
+	s.reload(context.Background())
+	s.reload(context.Background())
+
+	snapshot, log := s.Acquire(s.Log)
+	log.Info("event handled")
+	if snapshot.Generation != 2 {
+		t.Errorf("generation = %d, want 2", snapshot.Generation)
+	}
+	if last := (*lines)[len(*lines)-1]; !strings.Contains(last, "event handled") || !strings.Contains(last, "configGeneration 2") {
+		t.Errorf("logged %q, want the config generation", last)
+	}
+	if !containsLine(*lines, "config reloaded[configGeneration 1") {
+		t.Errorf("reload generation not logged: %v", *lines)
+	}
+}
+
+func containsLine(lines []string, substr string) bool {
+	for _, line := range lines {
+		if strings.Contains(line, substr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,547 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package configupdater applies the KLoops config manifests stored in git repositories (config as code).
+//
+// Pull requests targeting the config updater branch get a comment with the changes merging them would apply,
+// pushes to the branch apply the manifests to the namespace of the repo config and prune the removed objects.
+// Only pull requests of authors allowed to push to the repository are commented, inline secret values are redacted from the comments.
+//
+// Manifests are read and applied impersonating the service account configured in the repo config,
+// the objects are applied with its permissions and go through the admission webhooks as its requests.
+// Existing objects are only updated or deleted when they were applied by the same repo config.
+package configupdater
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	buildv1alpha1 "github.com/kloops-io/kloops/apis/build/v1alpha1"
+	configv1alpha1 "github.com/kloops-io/kloops/apis/config/v1alpha1"
+	"github.com/kloops-io/kloops/pkg/scm"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/yaml"
+)
+
+// ManagedByLabel is set on the objects applied by the config updater, its value is the name of the repo config
+const ManagedByLabel = "config.kloops.io/config-updater"
+
+// Kinds are the kinds managed by the config updater, in the version used to validate and prune them
+var Kinds = []schema.GroupVersionKind{
+	configv1alpha1.GroupVersion.WithKind("RepoConfig"),
+	configv1alpha1.GroupVersion.WithKind("OrgConfig"),
+	configv1alpha1.GroupVersion.WithKind("PluginConfig"),
+	buildv1alpha1.GroupVersion.WithKind("Job"),
+}
+
+// ErrConflict is returned when the manifests define objects not managed by the repo config
+var ErrConflict = errors.New("conflict")
+
+// manifestExtensions are the extensions of the files read from directories
+var manifestExtensions = map[string]bool{".yaml": true, ".yml": true, ".json": true}
+
+// Action is the action applied to an object
+type Action string
+
+// Actions applied to objects
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Change defines a change applied to an object
+type Change struct {
+	Action Action
+	Kind   string
+	Name   string
+	// Diff is the line diff of the object spec
+	Diff   string
+	object *unstructured.Unstructured
+}
+
+// Plan defines the changes applied to a namespace
+type Plan struct {
+	Namespace string
+	// Owner is the name of the repo config managing the objects
+	Owner   string
+	Changes []Change
+}
+
+// Updater loads, plans and applies config manifests
+type Updater struct {
+	// Client reads and applies the objects of Plan and Apply,
+	// HandleWebhook uses a client impersonating the service account of the repo config instead
+	Client client.Client
+	// Config is the rest config the impersonating clients are created from
+	Config *rest.Config
+	// Mapper is the rest mapper of the impersonating clients, discovered when nil
+	Mapper meta.RESTMapper
+	Scheme *runtime.Scheme
+	Log    logr.Logger
+}
+
+// +kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=impersonate
+
+// HandleWebhook comments the planned changes on the pull requests targeting the config updater branch,
+// and applies the manifests pushed to the branch.
+// spec is the effective spec of the repo config, objects are applied to the repo config namespace.
+func (u *Updater) HandleWebhook(ctx context.Context, repoConfig *configv1alpha1.RepoConfig, spec *configv1alpha1.RepoConfigSpec, scmClient scm.Client, hook *scm.Webhook) error {
+	config := spec.ConfigUpdater
+	if config == nil {
+		return nil
+	}
+	branch := config.Branch
+	if branch == "" {
+		branch = configv1alpha1.DefaultConfigUpdaterBranch
+	}
+	log := u.Log.WithValues("repoconfig", types.NamespacedName{Namespace: repoConfig.Namespace, Name: repoConfig.Name})
+	switch {
+	case hook.Kind == scm.WebhookPush && hook.Ref == "refs/heads/"+branch && strings.Trim(hook.After, "0") != "":
+		impersonated, err := u.impersonate(repoConfig.Namespace, config.ServiceAccountName)
+		if err != nil {
+			return err
+		}
+		objs, err := impersonated.Load(ctx, scmClient, config, repoConfig.Namespace, hook.After)
+		if err != nil {
+			return err
+		}
+		plan, err := impersonated.Plan(ctx, repoConfig.Namespace, repoConfig.Name, objs, config.Prune)
+		if err != nil {
+			return err
+		}
+		if err := impersonated.Apply(ctx, plan); err != nil {
+			return err
+		}
+		log.Info("config applied", "sha", hook.After, "changes", len(plan.Changes))
+	case hook.Kind == scm.WebhookPullRequest && hook.PullRequest != nil && hook.PullRequest.BaseRef == branch:
+		if hook.Action != scm.ActionOpened && hook.Action != scm.ActionSynchronize && hook.Action != scm.ActionReopened {
+			return nil
+		}
+		// the comment discloses the objects of the namespace, untrusted authors must not get it
+		permissions, err := scmClient.UserPermissions(ctx, hook.PullRequest.Author)
+		if err != nil {
+			return fmt.Errorf("failed to get the permissions of %s: %w", hook.PullRequest.Author, err)
+		}
+		if !permissions.Push {
+			log.Info("config changes not commented, the pull request author cannot push to the repository", "number", hook.PullRequest.Number, "author", hook.PullRequest.Author)
+			return nil
+		}
+		impersonated, err := u.impersonate(repoConfig.Namespace, config.ServiceAccountName)
+		if err != nil {
+			return err
+		}
+		var comment string
+		objs, err := impersonated.Load(ctx, scmClient, config, repoConfig.Namespace, hook.PullRequest.HeadSHA)
+		if err == nil {
+			var plan *Plan
+			plan, err = impersonated.Plan(ctx, repoConfig.Namespace, repoConfig.Name, objs, config.Prune)
+			if err != nil && !errors.Is(err, ErrConflict) {
+				return err
+			}
+			if err == nil {
+				if len(plan.Changes) == 0 {
+					return nil
+				}
+				comment = plan.Comment()
+			}
+		}
+		if err != nil {
+			comment = fmt.Sprintf("#### KLoops config\n\nThe config manifests cannot be applied:\n\n```\n%s\n```\n", err)
+		}
+		if err := scmClient.CreateComment(ctx, hook.PullRequest.Number, comment); err != nil {
+			return fmt.Errorf("failed to comment config changes: %w", err)
+		}
+		log.Info("config changes commented", "number", hook.PullRequest.Number, "sha", hook.PullRequest.HeadSHA)
+	}
+	return nil
+}
+
+// impersonate returns an updater whose client impersonates the given service account of the namespace
+func (u *Updater) impersonate(namespace, serviceAccount string) (*Updater, error) {
+	if serviceAccount == "" {
+		return nil, errors.New("the config updater service account is not set")
+	}
+	config := rest.CopyConfig(u.Config)
+	config.Impersonate = rest.ImpersonationConfig{
+		UserName: fmt.Sprintf("system:serviceaccount:%s:%s", namespace, serviceAccount),
+		Groups:   []string{"system:serviceaccounts", "system:serviceaccounts:" + namespace, "system:authenticated"},
+	}
+	c, err := client.New(config, client.Options{Scheme: u.Scheme, Mapper: u.Mapper})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a client impersonating service account %s: %w", serviceAccount, err)
+	}
+	return &Updater{Client: c, Config: u.Config, Mapper: u.Mapper, Scheme: u.Scheme, Log: u.Log}, nil
+}
+
+// Load reads the manifests of the config updater at the given ref, and validates them for the given namespace
+func (u *Updater) Load(ctx context.Context, scmClient scm.Client, config *configv1alpha1.ConfigUpdater, namespace, ref string) ([]*unstructured.Unstructured, error) {
+	var files []string
+	for _, p := range config.Paths {
+		if manifestExtensions[path.Ext(p)] {
+			files = append(files, p)
+			continue
+		}
+		dirFiles, err := scmClient.ListFiles(ctx, p, ref)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list files of %s: %w", p, err)
+		}
+		for _, file := range dirFiles {
+			if manifestExtensions[path.Ext(file)] {
+				files = append(files, file)
+			}
+		}
+	}
+	var objs []*unstructured.Unstructured
+	seen := map[string]string{}
+	for _, file := range files {
+		data, err := scmClient.GetFile(ctx, file, ref)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		fileObjs, err := decode(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", file, err)
+		}
+		for _, obj := range fileObjs {
+			if err := u.validate(obj, namespace); err != nil {
+				return nil, fmt.Errorf("%s: %s %s: %w", file, obj.GetKind(), obj.GetName(), err)
+			}
+			key := obj.GetKind() + "/" + obj.GetName()
+			if other, ok := seen[key]; ok {
+				return nil, fmt.Errorf("%s: %s is already defined in %s", file, key, other)
+			}
+			seen[key] = file
+			objs = append(objs, obj)
+		}
+	}
+	return objs, nil
+}
+
+func decode(data []byte) ([]*unstructured.Unstructured, error) {
+	decoder := yamlutil.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+	var objs []*unstructured.Unstructured
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				return objs, nil
+			}
+			return nil, err
+		}
+		if len(obj.Object) != 0 {
+			objs = append(objs, obj)
+		}
+	}
+}
+
+// validate checks that the object kind is managed, sets its namespace and runs the admission webhooks of its type
+func (u *Updater) validate(obj *unstructured.Unstructured, namespace string) error {
+	gvk := obj.GroupVersionKind()
+	var kind *schema.GroupVersionKind
+	for i := range Kinds {
+		if Kinds[i].GroupKind() == gvk.GroupKind() {
+			kind = &Kinds[i]
+		}
+	}
+	if kind == nil {
+		return fmt.Errorf("kind %s is not managed by the config updater", gvk.GroupKind())
+	}
+	if obj.GetName() == "" {
+		return errors.New("name is required")
+	}
+	if obj.GetNamespace() != "" && obj.GetNamespace() != namespace {
+		return fmt.Errorf("namespace must be %s", namespace)
+	}
+	obj.SetNamespace(namespace)
+	typed, err := u.typed(obj)
+	if err != nil {
+		return err
+	}
+	if validator, ok := typed.(webhook.Validator); ok {
+		return validator.ValidateCreate()
+	}
+	return nil
+}
+
+// typed returns the defaulted typed object of an unstructured object, in the version of its kind listed in Kinds
+func (u *Updater) typed(obj *unstructured.Unstructured) (runtime.Object, error) {
+	gvk := obj.GroupVersionKind()
+	typed, err := u.Scheme.New(gvk)
+	if err != nil {
+		return nil, err
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), typed); err != nil {
+		return nil, err
+	}
+	for _, kind := range Kinds {
+		if kind.GroupKind() != gvk.GroupKind() || kind.Version == gvk.Version {
+			continue
+		}
+		converted, err := u.Scheme.New(kind)
+		if err != nil {
+			return nil, err
+		}
+		if err := u.Scheme.Convert(typed, converted, nil); err != nil {
+			return nil, err
+		}
+		typed = converted
+	}
+	if defaulter, ok := typed.(webhook.Defaulter); ok {
+		defaulter.Default()
+	}
+	return typed, nil
+}
+
+// Plan computes the changes applying the objects to the namespace would make.
+// owner is the name of the repo config managing the objects, objects it applied before are deleted when prune is true.
+// An ErrConflict error is returned when an existing object was not applied by the owner.
+func (u *Updater) Plan(ctx context.Context, namespace, owner string, objs []*unstructured.Unstructured, prune bool) (*Plan, error) {
+	plan := &Plan{Namespace: namespace, Owner: owner}
+	desired := map[string]bool{}
+	for _, obj := range objs {
+		desired[obj.GroupVersionKind().GroupKind().String()+"/"+obj.GetName()] = true
+		existing := &unstructured.Unstructured{}
+		existing.SetGroupVersionKind(obj.GroupVersionKind())
+		if err := u.Client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: obj.GetName()}, existing); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("failed to get %s %s: %w", obj.GetKind(), obj.GetName(), err)
+			}
+			plan.Changes = append(plan.Changes, Change{Action: ActionCreate, Kind: obj.GetKind(), Name: obj.GetName(), Diff: diff(nil, u.normalizedSpec(obj, true)), object: obj})
+			continue
+		}
+		if err := checkManagedBy(existing, owner); err != nil {
+			return nil, err
+		}
+		if equality.Semantic.DeepEqual(u.normalizedSpec(existing, false), u.normalizedSpec(obj, false)) {
+			continue
+		}
+		plan.Changes = append(plan.Changes, Change{Action: ActionUpdate, Kind: obj.GetKind(), Name: obj.GetName(), Diff: diff(u.normalizedSpec(existing, true), u.normalizedSpec(obj, true)), object: obj})
+	}
+	if prune {
+		for _, kind := range Kinds {
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(kind.GroupVersion().WithKind(kind.Kind + "List"))
+			if err := u.Client.List(ctx, list, client.InNamespace(namespace), client.MatchingLabels{ManagedByLabel: owner}); err != nil {
+				return nil, fmt.Errorf("failed to list %s: %w", kind.Kind, err)
+			}
+			for i := range list.Items {
+				obj := &list.Items[i]
+				if !desired[kind.GroupKind().String()+"/"+obj.GetName()] {
+					plan.Changes = append(plan.Changes, Change{Action: ActionDelete, Kind: kind.Kind, Name: obj.GetName(), Diff: diff(u.normalizedSpec(obj, true), nil), object: obj})
+				}
+			}
+		}
+	}
+	sort.SliceStable(plan.Changes, func(i, j int) bool {
+		if plan.Changes[i].Kind != plan.Changes[j].Kind {
+			return plan.Changes[i].Kind < plan.Changes[j].Kind
+		}
+		return plan.Changes[i].Name < plan.Changes[j].Name
+	})
+	return plan, nil
+}
+
+// Apply applies the changes of a plan
+func (u *Updater) Apply(ctx context.Context, plan *Plan) error {
+	for _, change := range plan.Changes {
+		obj := change.object.DeepCopy()
+		switch change.Action {
+		case ActionCreate:
+			setManagedBy(obj, change.object.GetLabels(), plan.Owner)
+			if err := u.Client.Create(ctx, obj); err != nil {
+				return fmt.Errorf("failed to create %s %s: %w", change.Kind, change.Name, err)
+			}
+		case ActionUpdate:
+			existing := &unstructured.Unstructured{}
+			existing.SetGroupVersionKind(obj.GroupVersionKind())
+			if err := u.Client.Get(ctx, types.NamespacedName{Namespace: plan.Namespace, Name: change.Name}, existing); err != nil {
+				return fmt.Errorf("failed to get %s %s: %w", change.Kind, change.Name, err)
+			}
+			// the object may have changed since the plan was computed
+			if err := checkManagedBy(existing, plan.Owner); err != nil {
+				return err
+			}
+			labels := existing.GetLabels()
+			if labels == nil {
+				labels = map[string]string{}
+			}
+			for k, v := range obj.GetLabels() {
+				labels[k] = v
+			}
+			setManagedBy(existing, labels, plan.Owner)
+			if spec, ok := obj.Object["spec"]; ok {
+				existing.Object["spec"] = spec
+			} else {
+				delete(existing.Object, "spec")
+			}
+			if err := u.Client.Update(ctx, existing); err != nil {
+				return fmt.Errorf("failed to update %s %s: %w", change.Kind, change.Name, err)
+			}
+		case ActionDelete:
+			if err := client.IgnoreNotFound(u.Client.Delete(ctx, obj)); err != nil {
+				return fmt.Errorf("failed to delete %s %s: %w", change.Kind, change.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// checkManagedBy returns an ErrConflict error if an existing object was not applied by the given repo config
+func checkManagedBy(existing *unstructured.Unstructured, owner string) error {
+	managedBy, ok := existing.GetLabels()[ManagedByLabel]
+	if !ok {
+		return fmt.Errorf("%w: %s %s exists and is not managed by the config updater", ErrConflict, existing.GetKind(), existing.GetName())
+	}
+	if managedBy != owner {
+		return fmt.Errorf("%w: %s %s is managed by repo config %s", ErrConflict, existing.GetKind(), existing.GetName(), managedBy)
+	}
+	return nil
+}
+
+func setManagedBy(obj *unstructured.Unstructured, labels map[string]string, owner string) {
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[ManagedByLabel] = owner
+	obj.SetLabels(labels)
+}
+
+// normalizedSpec returns the defaulted spec of an object without null values, so that specs can be compared.
+// Inline secret values are replaced when redact is true, specs shown to users must be redacted.
+// Specs that cannot be decoded are returned as is unless redacted, they are then omitted.
+func (u *Updater) normalizedSpec(obj *unstructured.Unstructured, redact bool) interface{} {
+	if obj == nil {
+		return nil
+	}
+	fallback := obj.Object["spec"]
+	if redact {
+		fallback = nil
+	}
+	typed, err := u.typed(obj)
+	if err != nil {
+		return fallback
+	}
+	if redact {
+		configv1alpha1.RedactSecrets(typed)
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(typed)
+	if err != nil {
+		return fallback
+	}
+	return withoutNulls(content["spec"])
+}
+
+func withoutNulls(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if item == nil {
+				delete(v, key)
+				continue
+			}
+			v[key] = withoutNulls(item)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = withoutNulls(v[i])
+		}
+	}
+	return value
+}
+
+// Comment formats the plan as a pull request comment
+func (p *Plan) Comment() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "#### KLoops config\n\nMerging this pull request will apply the following changes to the `%s` namespace:\n", p.Namespace)
+	for _, change := range p.Changes {
+		fmt.Fprintf(&b, "\n**%s** %s `%s`\n", change.Action, change.Kind, change.Name)
+		if change.Diff != "" {
+			fmt.Fprintf(&b, "```diff\n%s```\n", change.Diff)
+		}
+	}
+	return b.String()
+}
+
+// diff returns the line diff of the yaml representations of two values, empty when they are equal
+func diff(from, to interface{}) string {
+	a, b := yamlLines(from), yamlLines(to)
+	if strings.Join(a, "\n") == strings.Join(b, "\n") {
+		return ""
+	}
+	// longest common subsequence of lines
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	var out strings.Builder
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			out.WriteString("  " + a[i] + "\n")
+			i++
+			j++
+		case j < len(b) && (i == len(a) || lcs[i][j+1] >= lcs[i+1][j]):
+			out.WriteString("+ " + b[j] + "\n")
+			j++
+		default:
+			out.WriteString("- " + a[i] + "\n")
+			i++
+		}
+	}
+	return out.String()
+}
+
+func yamlLines(value interface{}) []string {
+	if value == nil {
+		return nil
+	}
+	data, err := yaml.Marshal(value)
+	if err != nil {
+		return []string{fmt.Sprint(value)}
+	}
+	return strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+}
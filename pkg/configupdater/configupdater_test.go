@@ -0,0 +1,103 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configupdater
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	configv1alpha1 "github.com/kloops-io/kloops/apis/config/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func pluginConfig(name, key string, labels map[string]string) *configv1alpha1.PluginConfig {
+	return &configv1alpha1.PluginConfig{
+		TypeMeta:   metav1.TypeMeta{APIVersion: configv1alpha1.GroupVersion.String(), Kind: "PluginConfig"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name, Labels: labels},
+		Spec:       configv1alpha1.PluginConfigSpec{Cat: configv1alpha1.Cat{Key: configv1alpha1.Secret{Value: key}}},
+	}
+}
+
+func TestPlan(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := configv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	managed := map[string]string{ManagedByLabel: "repo"}
+	tests := []struct {
+		name     string
+		existing *configv1alpha1.PluginConfig
+		wantErr  error
+		want     []Action
+	}{{
+		name: "create",
+		want: []Action{ActionCreate},
+	}, {
+		name:     "secret value changed",
+		existing: pluginConfig("plugins", "old-key", managed),
+		want:     []Action{ActionUpdate},
+	}, {
+		name:     "unchanged",
+		existing: pluginConfig("plugins", "new-key", managed),
+	}, {
+		name:     "not managed",
+		existing: pluginConfig("plugins", "old-key", nil),
+		wantErr:  ErrConflict,
+	}, {
+		name:     "managed by another repo config",
+		existing: pluginConfig("plugins", "old-key", map[string]string{ManagedByLabel: "other"}),
+		wantErr:  ErrConflict,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var objs []runtime.Object
+			if tt.existing != nil {
+				objs = append(objs, tt.existing)
+			}
+			u := &Updater{Client: fake.NewFakeClientWithScheme(scheme, objs...), Scheme: scheme}
+			content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pluginConfig("plugins", "new-key", nil))
+			if err != nil {
+				t.Fatal(err)
+			}
+			plan, err := u.Plan(context.Background(), "ns", "repo", []*unstructured.Unstructured{{Object: content}}, false)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Plan() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			var got []Action
+			for _, change := range plan.Changes {
+				got = append(got, change.Action)
+				if strings.Contains(change.Diff, "-key") {
+					t.Errorf("diff discloses a secret value:\n%s", change.Diff)
+				}
+			}
+			if len(got) != len(tt.want) || (len(got) != 0 && got[0] != tt.want[0]) {
+				t.Errorf("Plan() actions = %v, want %v", got, tt.want)
+			}
+			if comment := plan.Comment(); strings.Contains(comment, "-key") {
+				t.Errorf("comment discloses a secret value:\n%s", comment)
+			}
+		})
+	}
+}
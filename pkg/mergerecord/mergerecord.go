@@ -0,0 +1,123 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mergerecord records the merges done by the bot as MergeRecord objects
+// and enforces the retention limits configured on the repository.
+//
+// Dry run records are kept apart: a dry run merge of the same pull request heads is recorded once,
+// and dry run records are pruned among themselves so that they never push real merges out of the history.
+package mergerecord
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	buildv1alpha1 "github.com/kloops-io/kloops/apis/build/v1alpha1"
+	configv1alpha1 "github.com/kloops-io/kloops/apis/config/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Create creates a merge record for the given repo config and prunes records exceeding the retention limits.
+// history is the merge history of the effective repo config spec, nothing is pruned when it is nil.
+// A dry run merge already recorded for the same pull request heads is not recorded again, the existing record is returned.
+func Create(ctx context.Context, c client.Client, repoConfig *configv1alpha1.RepoConfig, history *configv1alpha1.MergeHistory, spec buildv1alpha1.MergeRecordSpec) (*buildv1alpha1.MergeRecord, error) {
+	if spec.DryRun {
+		records, err := List(ctx, c, repoConfig.Namespace, repoConfig.Name)
+		if err != nil {
+			return nil, err
+		}
+		for i := range records {
+			if records[i].Spec.DryRun && sameMerge(&records[i].Spec, &spec) {
+				return &records[i], nil
+			}
+		}
+	}
+	record := &buildv1alpha1.MergeRecord{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: repoConfig.Name + "-",
+			Namespace:    repoConfig.Namespace,
+			Labels: map[string]string{
+				buildv1alpha1.RepoConfigLabel: repoConfig.Name,
+			},
+		},
+		Spec: spec,
+	}
+	if err := c.Create(ctx, record); err != nil {
+		return nil, fmt.Errorf("failed to create merge record: %w", err)
+	}
+	if err := Prune(ctx, c, repoConfig.Namespace, repoConfig.Name, history, time.Now()); err != nil {
+		return record, err
+	}
+	return record, nil
+}
+
+// List returns the merge records of the given repo config, most recent first
+func List(ctx context.Context, c client.Client, namespace, repoConfig string) ([]buildv1alpha1.MergeRecord, error) {
+	var list buildv1alpha1.MergeRecordList
+	if err := c.List(ctx, &list, client.InNamespace(namespace), client.MatchingLabels{buildv1alpha1.RepoConfigLabel: repoConfig}); err != nil {
+		return nil, fmt.Errorf("failed to list merge records: %w", err)
+	}
+	records := list.Items
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[j].Spec.MergedAt.Before(&records[i].Spec.MergedAt)
+	})
+	return records, nil
+}
+
+// Prune deletes the merge records of the given repo config exceeding the retention limits
+func Prune(ctx context.Context, c client.Client, namespace, repoConfig string, history *configv1alpha1.MergeHistory, now time.Time) error {
+	if history == nil || (history.MaxRecords <= 0 && history.MaxAge == nil) {
+		return nil
+	}
+	records, err := List(ctx, c, namespace, repoConfig)
+	if err != nil {
+		return err
+	}
+	// real and dry run records are counted separately
+	index := map[bool]int{}
+	for i := range records {
+		record := &records[i]
+		position := index[record.Spec.DryRun]
+		index[record.Spec.DryRun]++
+		expired := history.MaxRecords > 0 && position >= history.MaxRecords
+		if history.MaxAge != nil && now.Sub(record.Spec.MergedAt.Time) > history.MaxAge.Duration {
+			expired = true
+		}
+		if !expired {
+			continue
+		}
+		if err := c.Delete(ctx, record); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete merge record %s: %w", record.Name, err)
+		}
+	}
+	return nil
+}
+
+// sameMerge tells whether two records merge the same pull request heads into the same branch
+func sameMerge(a, b *buildv1alpha1.MergeRecordSpec) bool {
+	if a.BaseRef != b.BaseRef || len(a.PullRequests) != len(b.PullRequests) {
+		return false
+	}
+	for i := range a.PullRequests {
+		if a.PullRequests[i].Number != b.PullRequests[i].Number || a.PullRequests[i].HeadSHA != b.PullRequests[i].HeadSHA {
+			return false
+		}
+	}
+	return true
+}
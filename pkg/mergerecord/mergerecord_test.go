@@ -0,0 +1,170 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mergerecord
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	buildv1alpha1 "github.com/kloops-io/kloops/apis/build/v1alpha1"
+	configv1alpha1 "github.com/kloops-io/kloops/apis/config/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var now = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func dryRunRecord(name string, age time.Duration) *buildv1alpha1.MergeRecord {
+	r := record(name, "repo", age)
+	r.Spec.DryRun = true
+	return r
+}
+
+func record(name, repoConfig string, age time.Duration) *buildv1alpha1.MergeRecord {
+	return &buildv1alpha1.MergeRecord{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name, Labels: map[string]string{buildv1alpha1.RepoConfigLabel: repoConfig}},
+		Spec:       buildv1alpha1.MergeRecordSpec{MergedAt: metav1.NewTime(now.Add(-age))},
+	}
+}
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := buildv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return scheme
+}
+
+func names(records []buildv1alpha1.MergeRecord) []string {
+	var out []string
+	for _, r := range records {
+		out = append(out, r.Name)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func TestPrune(t *testing.T) {
+	tests := []struct {
+		name    string
+		history *configv1alpha1.MergeHistory
+		want    []string
+	}{{
+		name: "no history",
+		want: []string{"a", "b", "c", "d"},
+	}, {
+		name:    "unlimited",
+		history: &configv1alpha1.MergeHistory{},
+		want:    []string{"a", "b", "c", "d"},
+	}, {
+		name:    "max records keeps the most recent",
+		history: &configv1alpha1.MergeHistory{MaxRecords: 2},
+		want:    []string{"a", "b"},
+	}, {
+		name:    "max age",
+		history: &configv1alpha1.MergeHistory{MaxAge: &metav1.Duration{Duration: 36 * time.Hour}},
+		want:    []string{"a", "b"},
+	}, {
+		name:    "max records and max age",
+		history: &configv1alpha1.MergeHistory{MaxRecords: 1, MaxAge: &metav1.Duration{Duration: 36 * time.Hour}},
+		want:    []string{"a"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewFakeClientWithScheme(newScheme(t),
+				record("c", "repo", 48*time.Hour),
+				record("a", "repo", time.Hour),
+				record("d", "repo", 72*time.Hour),
+				record("b", "repo", 24*time.Hour),
+				record("other", "other-repo", 96*time.Hour),
+			)
+			if err := Prune(context.Background(), c, "ns", "repo", tt.history, now); err != nil {
+				t.Fatal(err)
+			}
+			records, err := List(context.Background(), c, "ns", "repo")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := names(records); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("records = %v, want %v", got, tt.want)
+			}
+			others, err := List(context.Background(), c, "ns", "other-repo")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(others) != 1 {
+				t.Errorf("records of other repo configs must not be pruned, got %v", names(others))
+			}
+		})
+	}
+}
+
+func TestPruneDryRun(t *testing.T) {
+	c := fake.NewFakeClientWithScheme(newScheme(t),
+		dryRunRecord("dry-a", time.Minute),
+		dryRunRecord("dry-b", 2*time.Minute),
+		dryRunRecord("dry-c", 3*time.Minute),
+		record("a", "repo", time.Hour),
+		record("b", "repo", 2*time.Hour),
+		record("c", "repo", 3*time.Hour),
+	)
+	if err := Prune(context.Background(), c, "ns", "repo", &configv1alpha1.MergeHistory{MaxRecords: 2}, now); err != nil {
+		t.Fatal(err)
+	}
+	records, err := List(context.Background(), c, "ns", "repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// more recent dry run records must not push real merges out of the history
+	if got, want := names(records), []string{"a", "b", "dry-a", "dry-b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("records = %v, want %v", got, want)
+	}
+}
+
+func TestCreateDryRun(t *testing.T) {
+	c := fake.NewFakeClientWithScheme(newScheme(t))
+	repoConfig := &configv1alpha1.RepoConfig{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "repo"}}
+	spec := func(sha string) buildv1alpha1.MergeRecordSpec {
+		return buildv1alpha1.MergeRecordSpec{
+			BaseRef:      "master",
+			PullRequests: []buildv1alpha1.MergedPullRequest{{Number: 1, HeadSHA: sha}},
+			MergedAt:     metav1.NewTime(now),
+			DryRun:       true,
+		}
+	}
+	for _, sha := range []string{"sha1", "sha1", "sha2"} {
+		if _, err := Create(context.Background(), c, repoConfig, nil, spec(sha)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	records, err := List(context.Background(), c, "ns", "repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var shas []string
+	for _, r := range records {
+		shas = append(shas, r.Spec.PullRequests[0].HeadSHA)
+	}
+	sort.Strings(shas)
+	if want := []string{"sha1", "sha2"}; !reflect.DeepEqual(shas, want) {
+		t.Errorf("recorded heads = %v, want %v", shas, want)
+	}
+}
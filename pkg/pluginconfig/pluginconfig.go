@@ -0,0 +1,81 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package pluginconfig resolves the effective plugin configs of repo configs,
+// layered from referenced PluginConfig and ClusterPluginConfig objects and inline specs.
+package pluginconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kloops-io/kloops/apis/config/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Plugin config reference errors
+var (
+	ErrNotFound  = errors.New("plugin config not found")
+	ErrForbidden = errors.New("plugin config cannot be read")
+)
+
+// Effective returns the effective plugin config of a repo plugin config: the referenced configs are merged in order,
+// then the inline spec is merged on top and the result is defaulted.
+// namespace is the namespace of the referencing object.
+func Effective(ctx context.Context, c client.Reader, namespace string, p *v1alpha1.RepoPluginConfig) (*v1alpha1.PluginConfigSpec, error) {
+	var spec v1alpha1.PluginConfigSpec
+	for _, ref := range p.AllRefs() {
+		refSpec, err := Get(ctx, c, namespace, ref)
+		if err != nil {
+			return nil, err
+		}
+		spec.Merge(refSpec)
+	}
+	if p.Spec != nil {
+		spec.Merge(p.Spec)
+	}
+	spec.Default()
+	return &spec, nil
+}
+
+// Get returns the spec of a referenced PluginConfig or ClusterPluginConfig,
+// namespace is the namespace of the referencing object.
+func Get(ctx context.Context, c client.Reader, namespace string, ref v1alpha1.PluginConfigRef) (*v1alpha1.PluginConfigSpec, error) {
+	key := ref.Key(namespace)
+	var spec v1alpha1.PluginConfigSpec
+	var err error
+	switch ref.RefKind() {
+	case v1alpha1.ClusterPluginConfigKind:
+		var clusterPluginConfig v1alpha1.ClusterPluginConfig
+		err = c.Get(ctx, key, &clusterPluginConfig)
+		spec = clusterPluginConfig.Spec
+	default:
+		var pluginConfig v1alpha1.PluginConfig
+		err = c.Get(ctx, key, &pluginConfig)
+		spec = pluginConfig.Spec
+	}
+	switch {
+	case apierrors.IsNotFound(err):
+		return nil, fmt.Errorf("%s: %w", ref.Describe(namespace), ErrNotFound)
+	case apierrors.IsForbidden(err):
+		return nil, fmt.Errorf("%s: %w, the controller service account needs get, list and watch permissions: %s", ref.Describe(namespace), ErrForbidden, err)
+	case err != nil:
+		return nil, fmt.Errorf("failed to get %s: %w", ref.Describe(namespace), err)
+	}
+	return &spec, nil
+}
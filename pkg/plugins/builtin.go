@@ -0,0 +1,38 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"github.com/kloops-io/kloops/pkg/scm"
+)
+
+// Built-in plugins
+func init() {
+	for _, plugin := range []Plugin{
+		{Name: "approve", Description: "Approves pull requests with /approve comments from OWNERS approvers", Events: []scm.WebhookKind{scm.WebhookPullRequest, scm.WebhookComment}, Default: true},
+		{Name: "cat", Description: "Posts a cat image on /meow comments", Events: []scm.WebhookKind{scm.WebhookComment}},
+		{Name: "goose", Description: "Posts a goose image on /honk comments", Events: []scm.WebhookKind{scm.WebhookComment}},
+		{Name: "label", Description: "Adds and removes labels with /label comments", Events: []scm.WebhookKind{scm.WebhookComment}, Default: true},
+		{Name: "lgtm", Description: "Adds the lgtm label with /lgtm comments from OWNERS reviewers", Events: []scm.WebhookKind{scm.WebhookPullRequest, scm.WebhookComment}, Default: true},
+		{Name: "owners-label", Description: "Adds the labels defined in OWNERS files to pull requests", Events: []scm.WebhookKind{scm.WebhookPullRequest}},
+		{Name: "size", Description: "Labels pull requests with their size", Events: []scm.WebhookKind{scm.WebhookPullRequest}, Default: true},
+		{Name: "verify-owners", Description: "Validates the OWNERS files changed by pull requests", Events: []scm.WebhookKind{scm.WebhookPullRequest}},
+		{Name: "welcome", Description: "Welcomes new contributors on their first pull request", Events: []scm.WebhookKind{scm.WebhookPullRequest}},
+	} {
+		Register(plugin)
+	}
+}
@@ -0,0 +1,143 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package plugins is the registry of the known plugins, used to validate and resolve the plugins enabled on repositories.
+package plugins
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/kloops-io/kloops/apis/config/v1alpha1"
+	"github.com/kloops-io/kloops/pkg/scm"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Plugin describes a known plugin
+type Plugin struct {
+	// Name is the plugin name used in repo configs
+	Name string
+	// Description is a short description of the plugin
+	Description string
+	// Events are the webhook kinds the plugin handles
+	Events []scm.WebhookKind
+	// Default enables the plugin on repositories that don't disable it
+	Default bool
+}
+
+// Handles tells whether the plugin handles the given webhook kind
+func (p *Plugin) Handles(kind scm.WebhookKind) bool {
+	for _, event := range p.Events {
+		if event == kind {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	pluginsMu sync.RWMutex
+	plugins   = map[string]Plugin{}
+)
+
+// Register makes a plugin known to the registry, registering a plugin name twice replaces it
+func Register(plugin Plugin) {
+	pluginsMu.Lock()
+	defer pluginsMu.Unlock()
+	plugins[plugin.Name] = plugin
+}
+
+// Get returns the registered plugin with the given name
+func Get(name string) (Plugin, bool) {
+	pluginsMu.RLock()
+	defer pluginsMu.RUnlock()
+	plugin, ok := plugins[name]
+	return plugin, ok
+}
+
+// Names returns the sorted names of the registered plugins
+func Names() []string {
+	pluginsMu.RLock()
+	defer pluginsMu.RUnlock()
+	names := make([]string, 0, len(plugins))
+	for name := range plugins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Defaults returns the sorted names of the plugins enabled by default
+func Defaults() []string {
+	pluginsMu.RLock()
+	defer pluginsMu.RUnlock()
+	var names []string
+	for name, plugin := range plugins {
+		if plugin.Default {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Enabled returns the sorted names of the plugins enabled by a repo plugin config, on top of the default ones
+func Enabled(config *v1alpha1.RepoPluginConfig) []string {
+	return config.EnabledPlugins(Defaults())
+}
+
+// Handles tells whether the plugin is enabled by the repo plugin config and handles the given webhook kind
+func Handles(config *v1alpha1.RepoPluginConfig, name string, kind scm.WebhookKind) bool {
+	plugin, ok := Get(name)
+	if !ok || !plugin.Handles(kind) || !config.HandlesEvent(name, v1alpha1.PluginEventType(kind)) {
+		return false
+	}
+	for _, enabled := range Enabled(config) {
+		if enabled == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that the plugins of a repo plugin config are registered and support the events they are restricted to
+func Validate(path *field.Path, config *v1alpha1.RepoPluginConfig) field.ErrorList {
+	var allErrs field.ErrorList
+	known := Names()
+	for i, name := range config.Plugins {
+		if _, ok := Get(name); !ok {
+			allErrs = append(allErrs, field.NotSupported(path.Child("plugins").Index(i), name, known))
+		}
+	}
+	for i, name := range config.DisabledPlugins {
+		if _, ok := Get(name); !ok {
+			allErrs = append(allErrs, field.NotSupported(path.Child("disabledPlugins").Index(i), name, known))
+		}
+	}
+	for i, pluginEvents := range config.PluginEvents {
+		plugin, ok := Get(pluginEvents.Plugin)
+		if !ok {
+			allErrs = append(allErrs, field.NotSupported(path.Child("pluginEvents").Index(i).Child("plugin"), pluginEvents.Plugin, known))
+			continue
+		}
+		for j, event := range pluginEvents.Events {
+			if !plugin.Handles(scm.WebhookKind(event)) {
+				allErrs = append(allErrs, field.Invalid(path.Child("pluginEvents").Index(i).Child("events").Index(j), event, "event type is not handled by plugin "+plugin.Name))
+			}
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,51 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package repoconfig resolves the effective configuration of repositories,
+// combining OrgConfig defaults with RepoConfig overrides.
+// Repositories matched by an OrgConfig without a RepoConfig use the OrgConfig settings as is.
+package repoconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kloops-io/kloops/apis/config/v1alpha1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ErrNotApplicable is returned when the OrgConfig referenced by a RepoConfig does not apply to its repository
+var ErrNotApplicable = errors.New("org config does not apply to the repository")
+
+// Effective returns the effective spec of a repo config, merged on top of the OrgConfig it references if any
+func Effective(ctx context.Context, c client.Reader, repoConfig *v1alpha1.RepoConfig) (*v1alpha1.RepoConfigSpec, error) {
+	if repoConfig.Spec.OrgConfig == "" {
+		return repoConfig.Spec.DeepCopy(), nil
+	}
+	var orgConfig v1alpha1.OrgConfig
+	if err := c.Get(ctx, types.NamespacedName{Namespace: repoConfig.Namespace, Name: repoConfig.Spec.OrgConfig}, &orgConfig); err != nil {
+		return nil, fmt.Errorf("failed to get org config %s: %w", repoConfig.Spec.OrgConfig, err)
+	}
+	if err := orgConfig.Spec.CheckRepo(&repoConfig.Spec); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrNotApplicable, err)
+	}
+	_, repo := repoConfig.Spec.Repository()
+	spec := orgConfig.Spec.ForRepo(repo)
+	spec = spec.Override(&repoConfig.Spec)
+	return &spec, nil
+}
@@ -0,0 +1,549 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/kloops-io/kloops/apis/config/v1alpha1"
+)
+
+// bitbucketServerHookEvents are the events Bitbucket Server webhooks are subscribed to
+var bitbucketServerHookEvents = []string{
+	"repo:refs_changed",
+	"pr:opened",
+	"pr:modified",
+	"pr:from_ref_updated",
+	"pr:merged",
+	"pr:declined",
+	"pr:comment:added",
+}
+
+type bitbucketServerUser struct {
+	Name string `json:"name"`
+}
+
+type bitbucketServerHook struct {
+	ID     int64    `json:"id"`
+	URL    string   `json:"url"`
+	Active bool     `json:"active"`
+	Events []string `json:"events"`
+}
+
+func (h bitbucketServerHook) toHook() Hook {
+	return Hook{ID: h.ID, URL: h.URL, Active: h.Active, Events: h.Events}
+}
+
+type bitbucketServerRepository struct {
+	Slug    string `json:"slug"`
+	Project struct {
+		Key string `json:"key"`
+	} `json:"project"`
+}
+
+func (r *bitbucketServerRepository) fullName() string {
+	return r.Project.Key + "/" + r.Slug
+}
+
+type bitbucketServerRef struct {
+	DisplayID    string                    `json:"displayId"`
+	LatestCommit string                    `json:"latestCommit"`
+	Repository   bitbucketServerRepository `json:"repository"`
+}
+
+type bitbucketServerPullRequest struct {
+	ID          int                `json:"id"`
+	Version     int                `json:"version"`
+	Title       string             `json:"title"`
+	Description string             `json:"description"`
+	State       string             `json:"state"`
+	CreatedDate int64              `json:"createdDate"`
+	FromRef     bitbucketServerRef `json:"fromRef"`
+	ToRef       bitbucketServerRef `json:"toRef"`
+	Author      struct {
+		User bitbucketServerUser `json:"user"`
+	} `json:"author"`
+}
+
+func (pr *bitbucketServerPullRequest) toPullRequest() *PullRequest {
+	return &PullRequest{
+		Number:  pr.ID,
+		Title:   pr.Title,
+		Body:    pr.Description,
+		Author:  pr.Author.User.Name,
+		HeadRef: pr.FromRef.DisplayID,
+		HeadSHA: pr.FromRef.LatestCommit,
+		BaseRef: pr.ToRef.DisplayID,
+		Merged:  pr.State == "MERGED",
+		Closed:  pr.State != "OPEN",
+		Created: time.Unix(0, pr.CreatedDate*int64(time.Millisecond)),
+	}
+}
+
+type bitbucketServer struct {
+	httpClient
+	project string
+	repo    string
+}
+
+func newBitbucketServer(repo *v1alpha1.BitbucketServerRepo, token string) *bitbucketServer {
+	return &bitbucketServer{
+		httpClient: httpClient{baseURL: repo.ServerURL, authorization: "Bearer " + token},
+		project:    repo.Project,
+		repo:       repo.Repo,
+	}
+}
+
+func (c *bitbucketServer) repoPath(path string) string {
+	return fmt.Sprintf("/rest/api/1.0/projects/%s/repos/%s%s", url.PathEscape(c.project), url.PathEscape(c.repo), path)
+}
+
+func (c *bitbucketServer) listOrgRepos(ctx context.Context, project string) ([]string, error) {
+	var names []string
+	start := 0
+	for {
+		var page struct {
+			Values []struct {
+				Slug string `json:"slug"`
+			} `json:"values"`
+			IsLastPage    bool `json:"isLastPage"`
+			NextPageStart int  `json:"nextPageStart"`
+		}
+		if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/rest/api/1.0/projects/%s/repos?start=%d&limit=100", url.PathEscape(project), start), nil, &page); err != nil {
+			return nil, err
+		}
+		for _, repo := range page.Values {
+			names = append(names, repo.Slug)
+		}
+		if page.IsLastPage || len(page.Values) == 0 {
+			return names, nil
+		}
+		start = page.NextPageStart
+	}
+}
+
+func (c *bitbucketServer) CurrentUser(ctx context.Context) (string, error) {
+	// bitbucket server has no current user endpoint, the user name comes back in a response header
+	header, err := c.doWithHeaders(ctx, http.MethodGet, "/rest/api/1.0/application-properties", nil, nil)
+	if err != nil {
+		return "", err
+	}
+	user := header.Get("X-AUSERNAME")
+	if user == "" {
+		return "", &Error{StatusCode: http.StatusUnauthorized, Message: "request was not authenticated"}
+	}
+	return user, nil
+}
+
+func (c *bitbucketServer) Permissions(ctx context.Context) (Permissions, error) {
+	// the name filter of the repos endpoint matches display names, which can differ from slugs,
+	// the repositories of the project are listed and matched by slug instead
+	hasPermission := func(permission string) (bool, error) {
+		start := 0
+		for {
+			var page struct {
+				Values []struct {
+					Slug string `json:"slug"`
+				} `json:"values"`
+				IsLastPage    bool `json:"isLastPage"`
+				NextPageStart int  `json:"nextPageStart"`
+			}
+			path := fmt.Sprintf("/rest/api/1.0/repos?projectkey=%s&permission=%s&start=%d&limit=100", url.QueryEscape(c.project), permission, start)
+			if err := c.do(ctx, http.MethodGet, path, nil, &page); err != nil {
+				return false, err
+			}
+			for _, repo := range page.Values {
+				if repo.Slug == c.repo {
+					return true, nil
+				}
+			}
+			if page.IsLastPage || len(page.Values) == 0 {
+				return false, nil
+			}
+			start = page.NextPageStart
+		}
+	}
+	var permissions Permissions
+	var err error
+	if permissions.Admin, err = hasPermission("REPO_ADMIN"); err != nil {
+		return Permissions{}, err
+	}
+	if permissions.Push, err = hasPermission("REPO_WRITE"); err != nil {
+		return Permissions{}, err
+	}
+	if permissions.Pull, err = hasPermission("REPO_READ"); err != nil {
+		return Permissions{}, err
+	}
+	return permissions, nil
+}
+
+func (c *bitbucketServer) UserPermissions(ctx context.Context, login string) (Permissions, error) {
+	// users get the highest of their repository and project permissions
+	var permissions Permissions
+	for _, path := range []string{c.repoPath("/permissions/users"), fmt.Sprintf("/rest/api/1.0/projects/%s/permissions/users", url.PathEscape(c.project))} {
+		var page struct {
+			Values []struct {
+				User struct {
+					Name string `json:"name"`
+				} `json:"user"`
+				Permission string `json:"permission"`
+			} `json:"values"`
+		}
+		if err := c.do(ctx, http.MethodGet, path+"?filter="+url.QueryEscape(login), nil, &page); err != nil {
+			return Permissions{}, err
+		}
+		for _, value := range page.Values {
+			if value.User.Name != login {
+				continue
+			}
+			switch value.Permission {
+			case "REPO_ADMIN", "PROJECT_ADMIN":
+				permissions.Admin = true
+				fallthrough
+			case "REPO_WRITE", "PROJECT_WRITE":
+				permissions.Push = true
+				fallthrough
+			case "REPO_READ", "PROJECT_READ":
+				permissions.Pull = true
+			}
+		}
+	}
+	return permissions, nil
+}
+
+func (c *bitbucketServer) ListHooks(ctx context.Context) ([]Hook, error) {
+	var page struct {
+		Values []bitbucketServerHook `json:"values"`
+	}
+	if err := c.do(ctx, http.MethodGet, c.repoPath("/webhooks"), nil, &page); err != nil {
+		return nil, err
+	}
+	var out []Hook
+	for _, hook := range page.Values {
+		out = append(out, hook.toHook())
+	}
+	return out, nil
+}
+
+func (c *bitbucketServer) CreateHook(ctx context.Context, hook HookSpec) (Hook, error) {
+	var out bitbucketServerHook
+	if err := c.do(ctx, http.MethodPost, c.repoPath("/webhooks"), newBitbucketServerHookRequest(hook), &out); err != nil {
+		return Hook{}, err
+	}
+	return out.toHook(), nil
+}
+
+func (c *bitbucketServer) UpdateHook(ctx context.Context, id int64, hook HookSpec) (Hook, error) {
+	var out bitbucketServerHook
+	if err := c.do(ctx, http.MethodPut, c.repoPath(fmt.Sprintf("/webhooks/%d", id)), newBitbucketServerHookRequest(hook), &out); err != nil {
+		return Hook{}, err
+	}
+	return out.toHook(), nil
+}
+
+func (c *bitbucketServer) DeleteHook(ctx context.Context, id int64) error {
+	return c.do(ctx, http.MethodDelete, c.repoPath(fmt.Sprintf("/webhooks/%d", id)), nil, nil)
+}
+
+func newBitbucketServerHookRequest(hook HookSpec) interface{} {
+	return map[string]interface{}{
+		"name":   "kloops",
+		"url":    hook.URL,
+		"active": true,
+		"events": bitbucketServerHookEvents,
+		"configuration": map[string]string{
+			"secret": hook.Secret,
+		},
+	}
+}
+
+func (c *bitbucketServer) CreateComment(ctx context.Context, number int, body string) error {
+	return c.do(ctx, http.MethodPost, c.repoPath(fmt.Sprintf("/pull-requests/%d/comments", number)), map[string]string{"text": body}, nil)
+}
+
+func (c *bitbucketServer) AddLabel(ctx context.Context, number int, label string) error {
+	// bitbucket server pull requests do not support labels
+	return ErrUnsupported
+}
+
+func (c *bitbucketServer) RemoveLabel(ctx context.Context, number int, label string) error {
+	return ErrUnsupported
+}
+
+func (c *bitbucketServer) CreateStatus(ctx context.Context, sha string, status Status) error {
+	req := map[string]string{
+		"state":       bitbucketServerState(status.State),
+		"key":         status.Context,
+		"name":        status.Context,
+		"description": status.Description,
+		"url":         status.TargetURL,
+	}
+	return c.do(ctx, http.MethodPost, "/rest/build-status/1.0/commits/"+sha, req, nil)
+}
+
+// bitbucketServerState maps commit status states to the bitbucket server build states
+func bitbucketServerState(state StatusState) string {
+	switch state {
+	case StatusSuccess:
+		return "SUCCESSFUL"
+	case StatusFailure, StatusError:
+		return "FAILED"
+	}
+	return "INPROGRESS"
+}
+
+func (c *bitbucketServer) ListStatuses(ctx context.Context, ref string) ([]Status, error) {
+	var page struct {
+		Values []struct {
+			State       string `json:"state"`
+			Key         string `json:"key"`
+			Description string `json:"description"`
+			URL         string `json:"url"`
+		} `json:"values"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/rest/build-status/1.0/commits/"+url.PathEscape(ref), nil, &page); err != nil {
+		return nil, err
+	}
+	var out []Status
+	for _, value := range page.Values {
+		state := StatusPending
+		switch value.State {
+		case "SUCCESSFUL":
+			state = StatusSuccess
+		case "FAILED":
+			state = StatusFailure
+		}
+		out = append(out, Status{State: state, Context: value.Key, Description: value.Description, TargetURL: value.URL})
+	}
+	return out, nil
+}
+
+func (c *bitbucketServer) GetBranchSHA(ctx context.Context, branch string) (string, error) {
+	var page struct {
+		Values []struct {
+			DisplayID    string `json:"displayId"`
+			LatestCommit string `json:"latestCommit"`
+		} `json:"values"`
+	}
+	if err := c.do(ctx, http.MethodGet, c.repoPath("/branches?filterText="+url.QueryEscape(branch)), nil, &page); err != nil {
+		return "", err
+	}
+	for _, value := range page.Values {
+		if value.DisplayID == branch {
+			return value.LatestCommit, nil
+		}
+	}
+	return "", &Error{StatusCode: http.StatusNotFound, Message: fmt.Sprintf("branch %s not found", branch)}
+}
+
+func (c *bitbucketServer) getPullRequest(ctx context.Context, number int) (*bitbucketServerPullRequest, error) {
+	var pr bitbucketServerPullRequest
+	if err := c.do(ctx, http.MethodGet, c.repoPath(fmt.Sprintf("/pull-requests/%d", number)), nil, &pr); err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+func (c *bitbucketServer) GetPullRequest(ctx context.Context, number int) (*PullRequest, error) {
+	pr, err := c.getPullRequest(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	out := pr.toPullRequest()
+	if !out.Closed {
+		var merge struct {
+			Conflicted bool `json:"conflicted"`
+		}
+		if err := c.do(ctx, http.MethodGet, c.repoPath(fmt.Sprintf("/pull-requests/%d/merge", number)), nil, &merge); err != nil {
+			return nil, err
+		}
+		mergeable := !merge.Conflicted
+		out.Mergeable = &mergeable
+	}
+	return out, nil
+}
+
+func (c *bitbucketServer) Merge(ctx context.Context, number int, options MergeOptions) error {
+	// merging requires the current pull request version
+	pr, err := c.getPullRequest(ctx, number)
+	if err != nil {
+		return err
+	}
+	if options.SHA != "" && pr.FromRef.LatestCommit != options.SHA {
+		return fmt.Errorf("pull request %d head moved from %s to %s", number, options.SHA, pr.FromRef.LatestCommit)
+	}
+	req := map[string]string{
+		"strategyId": bitbucketServerStrategy(options.Method),
+	}
+	if options.CommitTitle != "" || options.CommitMessage != "" {
+		req["message"] = strings.TrimSpace(options.CommitTitle + "\n\n" + options.CommitMessage)
+	}
+	return c.do(ctx, http.MethodPost, c.repoPath(fmt.Sprintf("/pull-requests/%d/merge?version=%d", number, pr.Version)), req, nil)
+}
+
+// bitbucketServerStrategy maps merge types to the bitbucket server merge strategies
+func bitbucketServerStrategy(method v1alpha1.PullRequestMergeType) string {
+	switch method {
+	case v1alpha1.MergeSquash:
+		return "squash"
+	case v1alpha1.MergeRebase:
+		return "rebase-no-ff"
+	}
+	return "no-ff"
+}
+
+func (c *bitbucketServer) UpdateBranch(ctx context.Context, number int, method v1alpha1.UpdateBranchMethod) error {
+	return ErrUnsupported
+}
+
+func (c *bitbucketServer) ListLabels(ctx context.Context) ([]Label, error) {
+	return nil, ErrUnsupported
+}
+
+func (c *bitbucketServer) CreateLabel(ctx context.Context, label Label) error {
+	return ErrUnsupported
+}
+
+func (c *bitbucketServer) UpdateLabel(ctx context.Context, name string, label Label) error {
+	return ErrUnsupported
+}
+
+func (c *bitbucketServer) DeleteLabel(ctx context.Context, name string) error {
+	return ErrUnsupported
+}
+
+func (c *bitbucketServer) GetBranchProtection(ctx context.Context, branch string) (*BranchProtection, error) {
+	return nil, ErrUnsupported
+}
+
+func (c *bitbucketServer) UpdateBranchProtection(ctx context.Context, branch string, protection BranchProtection) error {
+	return ErrUnsupported
+}
+
+func (c *bitbucketServer) GetFile(ctx context.Context, path, ref string) ([]byte, error) {
+	return c.getRaw(ctx, c.repoPath("/raw"+escapeFilePath(path)+"?at="+url.QueryEscape(ref)))
+}
+
+func (c *bitbucketServer) ListFiles(ctx context.Context, dir, ref string) ([]string, error) {
+	// the files api lists the files of the directory and its sub directories relative to the directory
+	prefix := ""
+	if dir = strings.Trim(dir, "/"); dir != "" {
+		prefix = dir + "/"
+	}
+	var files []string
+	start := 0
+	for {
+		var page struct {
+			Values        []string `json:"values"`
+			IsLastPage    bool     `json:"isLastPage"`
+			NextPageStart int      `json:"nextPageStart"`
+		}
+		path := fmt.Sprintf("/files%s?at=%s&start=%d&limit=100", escapeFilePath(dir), url.QueryEscape(ref), start)
+		if err := c.do(ctx, http.MethodGet, c.repoPath(path), nil, &page); err != nil {
+			return nil, err
+		}
+		for _, file := range page.Values {
+			files = append(files, prefix+file)
+		}
+		if page.IsLastPage || len(page.Values) == 0 {
+			return files, nil
+		}
+		start = page.NextPageStart
+	}
+}
+
+func (c *bitbucketServer) ParseWebhook(req *http.Request, secret string) (*Webhook, error) {
+	payload, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateHmac(sha256.New, secret, payload, strings.TrimPrefix(req.Header.Get("X-Hub-Signature"), "sha256=")); err != nil {
+		return nil, err
+	}
+	var event struct {
+		Actor       bitbucketServerUser         `json:"actor"`
+		Repository  bitbucketServerRepository   `json:"repository"`
+		PullRequest *bitbucketServerPullRequest `json:"pullRequest"`
+		Comment     *struct {
+			ID     int64               `json:"id"`
+			Text   string              `json:"text"`
+			Author bitbucketServerUser `json:"author"`
+		} `json:"comment"`
+		Changes []struct {
+			RefID    string `json:"refId"`
+			FromHash string `json:"fromHash"`
+			ToHash   string `json:"toHash"`
+		} `json:"changes"`
+	}
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+	webhook := &Webhook{Sender: event.Actor.Name, Repo: event.Repository.fullName()}
+	if event.PullRequest != nil {
+		// pull request events carry the repository in the target ref
+		webhook.Repo = event.PullRequest.ToRef.Repository.fullName()
+		webhook.PullRequest = event.PullRequest.toPullRequest()
+		webhook.Number = event.PullRequest.ID
+	}
+	key := req.Header.Get("X-Event-Key")
+	switch key {
+	case "repo:refs_changed":
+		if len(event.Changes) == 0 {
+			return nil, ErrUnknownEvent
+		}
+		webhook.Kind = WebhookPush
+		webhook.Ref = event.Changes[0].RefID
+		webhook.Before = event.Changes[0].FromHash
+		webhook.After = event.Changes[0].ToHash
+	case "pr:opened", "pr:modified", "pr:from_ref_updated", "pr:merged", "pr:declined":
+		if webhook.PullRequest == nil {
+			return nil, ErrUnknownEvent
+		}
+		webhook.Kind = WebhookPullRequest
+		webhook.Action = bitbucketServerAction(key)
+	case "pr:comment:added":
+		if webhook.PullRequest == nil || event.Comment == nil {
+			return nil, ErrUnknownEvent
+		}
+		webhook.Kind = WebhookComment
+		webhook.Action = ActionCreated
+		webhook.Comment = &Comment{ID: event.Comment.ID, Body: event.Comment.Text, Author: event.Comment.Author.Name}
+	default:
+		return nil, ErrUnknownEvent
+	}
+	return webhook, nil
+}
+
+// bitbucketServerAction maps bitbucket server event keys to the GitHub pull request actions
+func bitbucketServerAction(key string) string {
+	switch key {
+	case "pr:opened":
+		return ActionOpened
+	case "pr:from_ref_updated":
+		return ActionSynchronize
+	case "pr:merged", "pr:declined":
+		return ActionClosed
+	}
+	return ActionEdited
+}
@@ -0,0 +1,373 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scm
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/kloops-io/kloops/apis/config/v1alpha1"
+)
+
+// fakeBitbucketServer is a fake bitbucket server api recording the requests it receives
+type fakeBitbucketServer struct {
+	t *testing.T
+	// routes maps "METHOD path?query" to the handler answering the request, paths are relative to the repository
+	// when they start with it, absolute otherwise
+	routes   map[string]func(body map[string]interface{}) (int, interface{})
+	requests []string
+	bodies   []map[string]interface{}
+}
+
+func newFakeBitbucketServer(t *testing.T) (*fakeBitbucketServer, *bitbucketServer) {
+	f := &fakeBitbucketServer{t: t, routes: map[string]func(map[string]interface{}) (int, interface{}){}}
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+	c := newBitbucketServer(&v1alpha1.BitbucketServerRepo{ServerURL: server.URL, Project: "PRJ", Repo: "my-repo"}, "token")
+	return f, c
+}
+
+func (f *fakeBitbucketServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if got := req.Header.Get("Authorization"); got != "Bearer token" {
+		f.t.Errorf("unexpected authorization %q", got)
+	}
+	const prefix = "/rest/api/1.0/projects/PRJ/repos/my-repo"
+	path := req.URL.EscapedPath()
+	if len(path) >= len(prefix) && path[:len(prefix)] == prefix {
+		path = path[len(prefix):]
+	}
+	route := req.Method + " " + path
+	if req.URL.RawQuery != "" {
+		route += "?" + req.URL.RawQuery
+	}
+	var body map[string]interface{}
+	if data, _ := ioutil.ReadAll(req.Body); len(data) > 0 {
+		if err := json.Unmarshal(data, &body); err != nil {
+			f.t.Errorf("invalid request body %s: %v", data, err)
+		}
+	}
+	f.requests = append(f.requests, route)
+	f.bodies = append(f.bodies, body)
+	handler, ok := f.routes[route]
+	if !ok {
+		f.t.Errorf("unexpected request %s", route)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	code, out := handler(body)
+	// raw contents are written as is, other responses are encoded as json
+	if raw, ok := out.([]byte); ok {
+		w.WriteHeader(code)
+		_, _ = w.Write(raw)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if out != nil {
+		_ = json.NewEncoder(w).Encode(out)
+	}
+}
+
+func repos(last bool, next int, slugs ...string) func(map[string]interface{}) (int, interface{}) {
+	var values []map[string]interface{}
+	for _, slug := range slugs {
+		// display names differ from slugs
+		values = append(values, map[string]interface{}{"slug": slug, "name": "My Repo " + slug})
+	}
+	return respond(http.StatusOK, map[string]interface{}{"values": values, "isLastPage": last, "nextPageStart": next})
+}
+
+func TestBitbucketServerPermissions(t *testing.T) {
+	f, c := newFakeBitbucketServer(t)
+	f.routes["GET /rest/api/1.0/repos?projectkey=PRJ&permission=REPO_ADMIN&start=0&limit=100"] = repos(true, 0, "other")
+	f.routes["GET /rest/api/1.0/repos?projectkey=PRJ&permission=REPO_WRITE&start=0&limit=100"] = repos(false, 2, "a", "b")
+	f.routes["GET /rest/api/1.0/repos?projectkey=PRJ&permission=REPO_WRITE&start=2&limit=100"] = repos(true, 0, "my-repo")
+	f.routes["GET /rest/api/1.0/repos?projectkey=PRJ&permission=REPO_READ&start=0&limit=100"] = repos(true, 0, "my-repo")
+
+	got, err := c.Permissions(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Permissions{Push: true, Pull: true}); got != want {
+		t.Errorf("Permissions() = %+v, want %+v", got, want)
+	}
+}
+
+func TestBitbucketServerUserPermissions(t *testing.T) {
+	f, c := newFakeBitbucketServer(t)
+	f.routes["GET /permissions/users?filter=jdoe"] = respond(http.StatusOK, map[string]interface{}{"values": []map[string]interface{}{
+		{"user": map[string]interface{}{"name": "jdoe2"}, "permission": "REPO_ADMIN"},
+		{"user": map[string]interface{}{"name": "jdoe"}, "permission": "REPO_READ"},
+	}})
+	f.routes["GET /rest/api/1.0/projects/PRJ/permissions/users?filter=jdoe"] = respond(http.StatusOK, map[string]interface{}{"values": []map[string]interface{}{
+		{"user": map[string]interface{}{"name": "jdoe"}, "permission": "PROJECT_WRITE"},
+	}})
+
+	got, err := c.UserPermissions(context.Background(), "jdoe")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Permissions{Push: true, Pull: true}); got != want {
+		t.Errorf("UserPermissions() = %+v, want %+v", got, want)
+	}
+}
+
+func TestBitbucketServerMerge(t *testing.T) {
+	pr := map[string]interface{}{
+		"id":      3,
+		"version": 7,
+		"title":   "Fix",
+		"state":   "OPEN",
+		"fromRef": map[string]interface{}{"displayId": "fix", "latestCommit": "head"},
+		"toRef":   map[string]interface{}{"displayId": "master", "latestCommit": "base"},
+		"author":  map[string]interface{}{"user": map[string]interface{}{"name": "jdoe"}},
+	}
+	tests := []struct {
+		name     string
+		options  MergeOptions
+		wantBody map[string]interface{}
+		wantErr  bool
+	}{{
+		name:     "merge",
+		options:  MergeOptions{Method: v1alpha1.MergeMerge, SHA: "head"},
+		wantBody: map[string]interface{}{"strategyId": "no-ff"},
+	}, {
+		name:     "squash with message",
+		options:  MergeOptions{Method: v1alpha1.MergeSquash, CommitTitle: "Fix (#3)", CommitMessage: "Details"},
+		wantBody: map[string]interface{}{"strategyId": "squash", "message": "Fix (#3)\n\nDetails"},
+	}, {
+		name:    "head moved",
+		options: MergeOptions{Method: v1alpha1.MergeMerge, SHA: "old"},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, c := newFakeBitbucketServer(t)
+			f.routes["GET /pull-requests/3"] = respond(http.StatusOK, pr)
+			f.routes["POST /pull-requests/3/merge?version=7"] = respond(http.StatusOK, nil)
+			err := c.Merge(context.Background(), 3, tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Merge() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if last := f.requests[len(f.requests)-1]; last != "GET /pull-requests/3" {
+					t.Errorf("unexpected request %s after the head moved", last)
+				}
+				return
+			}
+			if body := f.bodies[len(f.bodies)-1]; !reflect.DeepEqual(body, tt.wantBody) {
+				t.Errorf("merge request = %v, want %v", body, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestBitbucketServerGetPullRequest(t *testing.T) {
+	f, c := newFakeBitbucketServer(t)
+	f.routes["GET /pull-requests/3"] = respond(http.StatusOK, map[string]interface{}{
+		"id":          3,
+		"title":       "Fix",
+		"state":       "OPEN",
+		"createdDate": 1590969600000,
+		"fromRef":     map[string]interface{}{"displayId": "fix", "latestCommit": "head"},
+		"toRef":       map[string]interface{}{"displayId": "master"},
+		"author":      map[string]interface{}{"user": map[string]interface{}{"name": "jdoe"}},
+	})
+	f.routes["GET /pull-requests/3/merge"] = respond(http.StatusOK, map[string]interface{}{"conflicted": true})
+
+	pr, err := c.GetPullRequest(context.Background(), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pr.Number != 3 || pr.Author != "jdoe" || pr.HeadSHA != "head" || pr.BaseRef != "master" || pr.Closed || pr.Created.Unix() != 1590969600 {
+		t.Errorf("GetPullRequest() = %+v", pr)
+	}
+	if pr.Mergeable == nil || *pr.Mergeable {
+		t.Errorf("GetPullRequest() mergeable = %v, want false", pr.Mergeable)
+	}
+}
+
+func TestBitbucketServerStatuses(t *testing.T) {
+	f, c := newFakeBitbucketServer(t)
+	ctx := context.Background()
+	f.routes["POST /rest/build-status/1.0/commits/head"] = respond(http.StatusNoContent, nil)
+	f.routes["GET /rest/build-status/1.0/commits/head"] = respond(http.StatusOK, map[string]interface{}{"values": []map[string]interface{}{
+		{"key": "ci", "state": "SUCCESSFUL", "url": "https://ci/1"},
+		{"key": "lint", "state": "FAILED"},
+		{"key": "e2e", "state": "INPROGRESS"},
+	}})
+	f.routes["GET /branches?filterText=master"] = respond(http.StatusOK, map[string]interface{}{"values": []map[string]interface{}{
+		{"displayId": "master-old", "latestCommit": "other"},
+		{"displayId": "master", "latestCommit": "base"},
+	}})
+
+	if err := c.CreateStatus(ctx, "head", Status{State: StatusError, Context: "kloops", Description: "failed"}); err != nil {
+		t.Fatal(err)
+	}
+	if body := f.bodies[len(f.bodies)-1]; body["state"] != "FAILED" || body["key"] != "kloops" {
+		t.Errorf("unexpected create status request %v", body)
+	}
+	statuses, err := c.ListStatuses(ctx, "head")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Status{
+		{State: StatusSuccess, Context: "ci", TargetURL: "https://ci/1"},
+		{State: StatusFailure, Context: "lint"},
+		{State: StatusPending, Context: "e2e"},
+	}
+	if !reflect.DeepEqual(statuses, want) {
+		t.Errorf("ListStatuses() = %+v, want %+v", statuses, want)
+	}
+	sha, err := c.GetBranchSHA(ctx, "master")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sha != "base" {
+		t.Errorf("GetBranchSHA() = %s, want base", sha)
+	}
+}
+
+func TestBitbucketServerFiles(t *testing.T) {
+	f, c := newFakeBitbucketServer(t)
+	ctx := context.Background()
+	f.routes["GET /raw/config/plugins.yaml?at=master"] = respond(http.StatusOK, []byte("plugins: [lgtm]\n"))
+	f.routes["GET /raw/config/missing.yaml?at=master"] = respond(http.StatusNotFound, map[string]interface{}{"errors": []interface{}{}})
+	f.routes["GET /files/config?at=master&start=0&limit=100"] = respond(http.StatusOK, map[string]interface{}{
+		"values":        []string{"plugins.yaml"},
+		"isLastPage":    false,
+		"nextPageStart": 1,
+	})
+	f.routes["GET /files/config?at=master&start=1&limit=100"] = respond(http.StatusOK, map[string]interface{}{
+		"values":     []string{"sub/labels.yaml"},
+		"isLastPage": true,
+	})
+
+	data, err := c.GetFile(ctx, "config/plugins.yaml", "master")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "plugins: [lgtm]\n" {
+		t.Errorf("GetFile() = %q", data)
+	}
+	if _, err := c.GetFile(ctx, "config/missing.yaml", "master"); !IsNotFound(err) {
+		t.Errorf("GetFile() error = %v, want not found", err)
+	}
+	files, err := c.ListFiles(ctx, "config", "master")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"config/plugins.yaml", "config/sub/labels.yaml"}; !reflect.DeepEqual(files, want) {
+		t.Errorf("ListFiles() = %v, want %v", files, want)
+	}
+}
+
+func TestBitbucketServerCreateComment(t *testing.T) {
+	f, c := newFakeBitbucketServer(t)
+	f.routes["POST /pull-requests/3/comments"] = respond(http.StatusCreated, map[string]interface{}{"id": 1})
+
+	if err := c.CreateComment(context.Background(), 3, "/lgtm"); err != nil {
+		t.Fatal(err)
+	}
+	if body := f.bodies[len(f.bodies)-1]; !reflect.DeepEqual(body, map[string]interface{}{"text": "/lgtm"}) {
+		t.Errorf("create comment request = %v", body)
+	}
+}
+
+func TestBitbucketServerParseWebhook(t *testing.T) {
+	pullRequest := map[string]interface{}{
+		"id":      3,
+		"state":   "OPEN",
+		"fromRef": map[string]interface{}{"displayId": "fix", "latestCommit": "head"},
+		"toRef":   map[string]interface{}{"displayId": "master", "repository": map[string]interface{}{"slug": "my-repo", "project": map[string]interface{}{"key": "PRJ"}}},
+	}
+	tests := []struct {
+		name      string
+		key       string
+		payload   map[string]interface{}
+		signature string
+		want      *Webhook
+		wantErr   error
+	}{{
+		name: "push",
+		key:  "repo:refs_changed",
+		payload: map[string]interface{}{
+			"actor":      map[string]interface{}{"name": "jdoe"},
+			"repository": map[string]interface{}{"slug": "my-repo", "project": map[string]interface{}{"key": "PRJ"}},
+			"changes":    []map[string]interface{}{{"refId": "refs/heads/master", "fromHash": "a", "toHash": "b"}},
+		},
+		want: &Webhook{Kind: WebhookPush, Sender: "jdoe", Repo: "PRJ/my-repo", Ref: "refs/heads/master", Before: "a", After: "b"},
+	}, {
+		name: "comment",
+		key:  "pr:comment:added",
+		payload: map[string]interface{}{
+			"actor":       map[string]interface{}{"name": "jdoe"},
+			"pullRequest": pullRequest,
+			"comment":     map[string]interface{}{"id": 9, "text": "/lgtm", "author": map[string]interface{}{"name": "jdoe"}},
+		},
+		want: &Webhook{Kind: WebhookComment, Action: ActionCreated, Sender: "jdoe", Repo: "PRJ/my-repo", Number: 3, Comment: &Comment{ID: 9, Body: "/lgtm", Author: "jdoe"}},
+	}, {
+		name:      "invalid signature",
+		key:       "repo:refs_changed",
+		payload:   map[string]interface{}{},
+		signature: "00",
+		wantErr:   ErrInvalidSignature,
+	}, {
+		name:    "unknown event",
+		key:     "repo:forked",
+		payload: map[string]interface{}{},
+		wantErr: ErrUnknownEvent,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload, err := json.Marshal(tt.payload)
+			if err != nil {
+				t.Fatal(err)
+			}
+			signature := tt.signature
+			if signature == "" {
+				mac := hmac.New(sha256.New, []byte("secret"))
+				mac.Write(payload)
+				signature = hex.EncodeToString(mac.Sum(nil))
+			}
+			req := httptest.NewRequest(http.MethodPost, "/hook", bytes.NewReader(payload))
+			req.Header.Set("X-Event-Key", tt.key)
+			req.Header.Set("X-Hub-Signature", "sha256="+signature)
+			got, err := (&bitbucketServer{}).ParseWebhook(req, "secret")
+			if err != tt.wantErr {
+				t.Fatalf("ParseWebhook() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			// pull request details are covered by the pull request tests
+			got.PullRequest = nil
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseWebhook() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
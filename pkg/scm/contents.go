@@ -0,0 +1,87 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scm
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// contentsEntry is an entry of the GitHub and Gitea contents api
+type contentsEntry struct {
+	Type     string `json:"type"`
+	Path     string `json:"path"`
+	Content  string `json:"content"`
+	Encoding string `json:"encoding"`
+}
+
+// escapeFilePath escapes every segment of a repository file path, it returns the path with a leading slash or an empty string for the root
+func escapeFilePath(path string) string {
+	escaped := ""
+	if path = strings.Trim(path, "/"); path != "" {
+		for _, segment := range strings.Split(path, "/") {
+			escaped += "/" + url.PathEscape(segment)
+		}
+	}
+	return escaped
+}
+
+// contentsPath returns the contents api path of a file or directory at the given ref
+func contentsPath(repoPath func(string) string, path, ref string) string {
+	return repoPath("/contents" + escapeFilePath(path) + "?ref=" + url.QueryEscape(ref))
+}
+
+// getContentsFile reads a file with the GitHub and Gitea contents api
+func getContentsFile(ctx context.Context, c *httpClient, repoPath func(string) string, path, ref string) ([]byte, error) {
+	var entry contentsEntry
+	if err := c.do(ctx, http.MethodGet, contentsPath(repoPath, path, ref), nil, &entry); err != nil {
+		return nil, err
+	}
+	if entry.Type != "file" {
+		return nil, fmt.Errorf("%s is a %s, not a file", path, entry.Type)
+	}
+	if entry.Encoding != "base64" {
+		return nil, fmt.Errorf("unsupported %s encoding of %s", entry.Encoding, path)
+	}
+	return base64.StdEncoding.DecodeString(entry.Content)
+}
+
+// listContentsFiles lists the files of a directory and its sub directories with the GitHub and Gitea contents api
+func listContentsFiles(ctx context.Context, c *httpClient, repoPath func(string) string, dir, ref string) ([]string, error) {
+	var entries []contentsEntry
+	if err := c.do(ctx, http.MethodGet, contentsPath(repoPath, dir, ref), nil, &entries); err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, entry := range entries {
+		switch entry.Type {
+		case "file":
+			files = append(files, entry.Path)
+		case "dir":
+			sub, err := listContentsFiles(ctx, c, repoPath, entry.Path, ref)
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, sub...)
+		}
+	}
+	return files, nil
+}
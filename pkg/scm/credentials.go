@@ -0,0 +1,52 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kloops-io/kloops/apis/config/v1alpha1"
+	"github.com/kloops-io/kloops/pkg/secrets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewClientFromSpec creates a client for the repository defined in the spec,
+// resolving its credentials from the secrets of the given namespace
+func NewClientFromSpec(ctx context.Context, c client.Reader, namespace string, spec *v1alpha1.RepoConfigSpec) (Client, error) {
+	if spec.GitHub != nil && spec.GitHub.App != nil {
+		app := spec.GitHub.App
+		privateKey, err := secrets.Get(ctx, c, namespace, app.PrivateKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve app private key: %w", err)
+		}
+		source, err := appTokenSourceFor(githubServerURL(spec.GitHub), app.AppID, app.InstallationID, []byte(privateKey))
+		if err != nil {
+			return nil, err
+		}
+		return newGitHubApp(spec.GitHub, source), nil
+	}
+	tokenSecret, err := TokenSecret(spec)
+	if err != nil {
+		return nil, err
+	}
+	token, err := secrets.Get(ctx, c, namespace, tokenSecret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve token: %w", err)
+	}
+	return NewClient(spec, token)
+}
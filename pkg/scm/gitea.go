@@ -0,0 +1,451 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/kloops-io/kloops/apis/config/v1alpha1"
+)
+
+type giteaHook struct {
+	ID     int64    `json:"id"`
+	Type   string   `json:"type"`
+	Active bool     `json:"active"`
+	Events []string `json:"events"`
+	Config struct {
+		URL string `json:"url"`
+	} `json:"config"`
+}
+
+// giteaHookEvents are the events Gitea webhooks are subscribed to
+var giteaHookEvents = []string{"create", "delete", "push", "issues", "issue_comment", "pull_request", "pull_request_review", "pull_request_sync"}
+
+// giteaPageSize is the page size used when listing gitea resources
+const giteaPageSize = 50
+
+type gitea struct {
+	httpClient
+	owner string
+	repo  string
+}
+
+func newGitea(repo *v1alpha1.GiteaRepo, token string) *gitea {
+	return &gitea{
+		httpClient: httpClient{baseURL: strings.TrimSuffix(repo.ServerURL, "/") + "/api/v1", authorization: "token " + token},
+		owner:      repo.Owner,
+		repo:       repo.Repo,
+	}
+}
+
+func (c *gitea) CurrentUser(ctx context.Context) (string, error) {
+	var user githubUser
+	if err := c.do(ctx, http.MethodGet, "/user", nil, &user); err != nil {
+		return "", err
+	}
+	return user.Login, nil
+}
+
+func (c *gitea) Permissions(ctx context.Context) (Permissions, error) {
+	var repo struct {
+		Permissions Permissions `json:"permissions"`
+	}
+	if err := c.do(ctx, http.MethodGet, c.repoPath(""), nil, &repo); err != nil {
+		return Permissions{}, err
+	}
+	return repo.Permissions, nil
+}
+
+func (c *gitea) UserPermissions(ctx context.Context, login string) (Permissions, error) {
+	var out struct {
+		Permission string `json:"permission"`
+	}
+	if err := c.do(ctx, http.MethodGet, c.repoPath("/collaborators/"+url.PathEscape(login)+"/permission"), nil, &out); err != nil {
+		if IsNotFound(err) {
+			return Permissions{}, nil
+		}
+		return Permissions{}, err
+	}
+	return roleToPermissions(out.Permission), nil
+}
+
+func (c *gitea) ListHooks(ctx context.Context) ([]Hook, error) {
+	var hooks []giteaHook
+	if err := c.do(ctx, http.MethodGet, c.repoPath("/hooks"), nil, &hooks); err != nil {
+		return nil, err
+	}
+	var out []Hook
+	for _, hook := range hooks {
+		out = append(out, hook.toHook())
+	}
+	return out, nil
+}
+
+func (c *gitea) listOrgRepos(ctx context.Context, owner string) ([]string, error) {
+	return listOwnerRepos(ctx, &c.httpClient, owner, "limit", giteaPageSize)
+}
+
+func (c *gitea) repoPath(path string) string {
+	return fmt.Sprintf("/repos/%s/%s%s", url.PathEscape(c.owner), url.PathEscape(c.repo), path)
+}
+
+func (c *gitea) CreateHook(ctx context.Context, hook HookSpec) (Hook, error) {
+	req := newGiteaHookRequest(hook)
+	req["type"] = "gitea"
+	var out giteaHook
+	if err := c.do(ctx, http.MethodPost, c.repoPath("/hooks"), req, &out); err != nil {
+		return Hook{}, err
+	}
+	return out.toHook(), nil
+}
+
+func (c *gitea) UpdateHook(ctx context.Context, id int64, hook HookSpec) (Hook, error) {
+	var out giteaHook
+	if err := c.do(ctx, http.MethodPatch, c.repoPath(fmt.Sprintf("/hooks/%d", id)), newGiteaHookRequest(hook), &out); err != nil {
+		return Hook{}, err
+	}
+	return out.toHook(), nil
+}
+
+func (c *gitea) DeleteHook(ctx context.Context, id int64) error {
+	return c.do(ctx, http.MethodDelete, c.repoPath(fmt.Sprintf("/hooks/%d", id)), nil, nil)
+}
+
+func (h giteaHook) toHook() Hook {
+	return Hook{ID: h.ID, URL: h.Config.URL, Active: h.Active, Events: h.Events}
+}
+
+func newGiteaHookRequest(hook HookSpec) map[string]interface{} {
+	return map[string]interface{}{
+		"active": true,
+		"events": giteaHookEvents,
+		"config": map[string]string{
+			"url":          hook.URL,
+			"content_type": "json",
+			"secret":       hook.Secret,
+		},
+	}
+}
+
+func (c *gitea) CreateComment(ctx context.Context, number int, body string) error {
+	return c.do(ctx, http.MethodPost, c.repoPath(fmt.Sprintf("/issues/%d/comments", number)), map[string]string{"body": body}, nil)
+}
+
+func (c *gitea) AddLabel(ctx context.Context, number int, label string) error {
+	id, err := c.labelID(ctx, label)
+	if err != nil {
+		return err
+	}
+	return c.do(ctx, http.MethodPost, c.repoPath(fmt.Sprintf("/issues/%d/labels", number)), map[string][]int64{"labels": {id}}, nil)
+}
+
+func (c *gitea) RemoveLabel(ctx context.Context, number int, label string) error {
+	id, err := c.labelID(ctx, label)
+	if err != nil {
+		return err
+	}
+	err = c.do(ctx, http.MethodDelete, c.repoPath(fmt.Sprintf("/issues/%d/labels/%d", number, id)), nil, nil)
+	if IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+type giteaLabel struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description"`
+}
+
+func (c *gitea) listLabels(ctx context.Context) ([]giteaLabel, error) {
+	var result []giteaLabel
+	for page := 1; ; page++ {
+		var labels []giteaLabel
+		if err := c.do(ctx, http.MethodGet, c.repoPath(fmt.Sprintf("/labels?page=%d&limit=%d", page, giteaPageSize)), nil, &labels); err != nil {
+			return nil, err
+		}
+		result = append(result, labels...)
+		if len(labels) < giteaPageSize {
+			return result, nil
+		}
+	}
+}
+
+// labelID returns the id of a repository label, gitea only accepts label ids when labelling issues
+func (c *gitea) labelID(ctx context.Context, name string) (int64, error) {
+	labels, err := c.listLabels(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, label := range labels {
+		if label.Name == name {
+			return label.ID, nil
+		}
+	}
+	return 0, &Error{StatusCode: http.StatusNotFound, Message: fmt.Sprintf("label %s not found", name)}
+}
+
+func (c *gitea) ListLabels(ctx context.Context) ([]Label, error) {
+	labels, err := c.listLabels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result []Label
+	for _, label := range labels {
+		result = append(result, Label{Name: label.Name, Color: strings.TrimPrefix(label.Color, "#"), Description: label.Description})
+	}
+	return result, nil
+}
+
+func (c *gitea) CreateLabel(ctx context.Context, label Label) error {
+	req := map[string]string{
+		"name":        label.Name,
+		"color":       "#" + label.Color,
+		"description": label.Description,
+	}
+	return c.do(ctx, http.MethodPost, c.repoPath("/labels"), req, nil)
+}
+
+func (c *gitea) UpdateLabel(ctx context.Context, name string, label Label) error {
+	id, err := c.labelID(ctx, name)
+	if err != nil {
+		return err
+	}
+	req := map[string]string{
+		"name":        label.Name,
+		"color":       "#" + label.Color,
+		"description": label.Description,
+	}
+	return c.do(ctx, http.MethodPatch, c.repoPath(fmt.Sprintf("/labels/%d", id)), req, nil)
+}
+
+func (c *gitea) DeleteLabel(ctx context.Context, name string) error {
+	id, err := c.labelID(ctx, name)
+	if err != nil {
+		return err
+	}
+	return c.do(ctx, http.MethodDelete, c.repoPath(fmt.Sprintf("/labels/%d", id)), nil, nil)
+}
+
+func (c *gitea) CreateStatus(ctx context.Context, sha string, status Status) error {
+	req := map[string]string{
+		"state":       string(status.State),
+		"context":     status.Context,
+		"description": status.Description,
+		"target_url":  status.TargetURL,
+	}
+	return c.do(ctx, http.MethodPost, c.repoPath("/statuses/"+sha), req, nil)
+}
+
+func (c *gitea) ListStatuses(ctx context.Context, ref string) ([]Status, error) {
+	var combined struct {
+		Statuses []githubStatus `json:"statuses"`
+	}
+	if err := c.do(ctx, http.MethodGet, c.repoPath("/commits/"+url.PathEscape(ref)+"/status"), nil, &combined); err != nil {
+		return nil, err
+	}
+	var out []Status
+	for _, status := range combined.Statuses {
+		out = append(out, status.toStatus())
+	}
+	return out, nil
+}
+
+func (c *gitea) GetBranchSHA(ctx context.Context, branch string) (string, error) {
+	var out struct {
+		Commit struct {
+			ID string `json:"id"`
+		} `json:"commit"`
+	}
+	if err := c.do(ctx, http.MethodGet, c.repoPath("/branches/"+url.PathEscape(branch)), nil, &out); err != nil {
+		return "", err
+	}
+	return out.Commit.ID, nil
+}
+
+func (c *gitea) GetPullRequest(ctx context.Context, number int) (*PullRequest, error) {
+	// gitea pull requests are serialized like GitHub ones
+	var pr githubPullRequest
+	if err := c.do(ctx, http.MethodGet, c.repoPath(fmt.Sprintf("/pulls/%d", number)), nil, &pr); err != nil {
+		return nil, err
+	}
+	return pr.toPullRequest(), nil
+}
+
+func (c *gitea) Merge(ctx context.Context, number int, options MergeOptions) error {
+	req := map[string]string{
+		"Do":                string(options.Method),
+		"MergeTitleField":   options.CommitTitle,
+		"MergeMessageField": options.CommitMessage,
+	}
+	if options.SHA != "" {
+		req["head_commit_id"] = options.SHA
+	}
+	return c.do(ctx, http.MethodPost, c.repoPath(fmt.Sprintf("/pulls/%d/merge", number)), req, nil)
+}
+
+func (c *gitea) UpdateBranch(ctx context.Context, number int, method v1alpha1.UpdateBranchMethod) error {
+	return c.do(ctx, http.MethodPost, c.repoPath(fmt.Sprintf("/pulls/%d/update?style=%s", number, method)), nil, nil)
+}
+
+type giteaBranchProtection struct {
+	EnableStatusCheck     bool     `json:"enable_status_check"`
+	StatusCheckContexts   []string `json:"status_check_contexts"`
+	RequiredApprovals     int      `json:"required_approvals"`
+	DismissStaleApprovals bool     `json:"dismiss_stale_approvals"`
+	EnablePush            bool     `json:"enable_push"`
+	EnablePushWhitelist   bool     `json:"enable_push_whitelist"`
+	PushWhitelistTeams    []string `json:"push_whitelist_teams"`
+}
+
+func (c *gitea) GetBranchProtection(ctx context.Context, branch string) (*BranchProtection, error) {
+	var protection giteaBranchProtection
+	if err := c.do(ctx, http.MethodGet, c.repoPath("/branch_protections/"+url.PathEscape(branch)), nil, &protection); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	result := &BranchProtection{
+		RequiredApprovals:   protection.RequiredApprovals,
+		DismissStaleReviews: protection.DismissStaleApprovals,
+	}
+	if protection.EnableStatusCheck {
+		result.RequiredStatusContexts = protection.StatusCheckContexts
+	}
+	if protection.EnablePushWhitelist {
+		result.PushTeams = protection.PushWhitelistTeams
+	}
+	return result, nil
+}
+
+// UpdateBranchProtection creates or replaces the protection rules of a branch, admins cannot be exempted from the rules on Gitea
+func (c *gitea) UpdateBranchProtection(ctx context.Context, branch string, protection BranchProtection) error {
+	if protection.EnforceAdmins {
+		return fmt.Errorf("enforce admins: %w", ErrUnsupported)
+	}
+	existing, err := c.GetBranchProtection(ctx, branch)
+	if err != nil {
+		return err
+	}
+	req := map[string]interface{}{
+		"enable_status_check":     len(protection.RequiredStatusContexts) != 0,
+		"status_check_contexts":   protection.RequiredStatusContexts,
+		"required_approvals":      protection.RequiredApprovals,
+		"dismiss_stale_approvals": protection.DismissStaleReviews,
+		"enable_push":             true,
+		"enable_push_whitelist":   len(protection.PushTeams) != 0,
+		"push_whitelist_teams":    protection.PushTeams,
+	}
+	if existing == nil {
+		req["branch_name"] = branch
+		return c.do(ctx, http.MethodPost, c.repoPath("/branch_protections"), req, nil)
+	}
+	return c.do(ctx, http.MethodPatch, c.repoPath("/branch_protections/"+url.PathEscape(branch)), req, nil)
+}
+
+func (c *gitea) GetFile(ctx context.Context, path, ref string) ([]byte, error) {
+	return getContentsFile(ctx, &c.httpClient, c.repoPath, path, ref)
+}
+
+func (c *gitea) ListFiles(ctx context.Context, dir, ref string) ([]string, error) {
+	return listContentsFiles(ctx, &c.httpClient, c.repoPath, dir, ref)
+}
+
+func (c *gitea) ParseWebhook(req *http.Request, secret string) (*Webhook, error) {
+	payload, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateHmac(sha256.New, secret, payload, req.Header.Get("X-Gitea-Signature")); err != nil {
+		return nil, err
+	}
+	switch req.Header.Get("X-Gitea-Event") {
+	case "push":
+		var event struct {
+			Ref        string           `json:"ref"`
+			Before     string           `json:"before"`
+			After      string           `json:"after"`
+			Repository githubRepository `json:"repository"`
+			Sender     githubUser       `json:"sender"`
+		}
+		if err := json.Unmarshal(payload, &event); err != nil {
+			return nil, err
+		}
+		return &Webhook{Kind: WebhookPush, Repo: event.Repository.FullName, Sender: event.Sender.Login, Ref: event.Ref, Before: event.Before, After: event.After}, nil
+	case "pull_request":
+		var event struct {
+			Action      string            `json:"action"`
+			PullRequest githubPullRequest `json:"pull_request"`
+			Repository  githubRepository  `json:"repository"`
+			Sender      githubUser        `json:"sender"`
+		}
+		if err := json.Unmarshal(payload, &event); err != nil {
+			return nil, err
+		}
+		pr := event.PullRequest.toPullRequest()
+		return &Webhook{Kind: WebhookPullRequest, Action: giteaAction(event.Action), Repo: event.Repository.FullName, Sender: event.Sender.Login, Number: pr.Number, PullRequest: pr}, nil
+	case "issue_comment":
+		var event struct {
+			Action     string           `json:"action"`
+			Issue      githubIssue      `json:"issue"`
+			Comment    githubComment    `json:"comment"`
+			Repository githubRepository `json:"repository"`
+			Sender     githubUser       `json:"sender"`
+			IsPull     bool             `json:"is_pull"`
+		}
+		if err := json.Unmarshal(payload, &event); err != nil {
+			return nil, err
+		}
+		webhook := &Webhook{
+			Kind:    WebhookComment,
+			Action:  event.Action,
+			Repo:    event.Repository.FullName,
+			Sender:  event.Sender.Login,
+			Number:  event.Issue.Number,
+			Comment: &Comment{ID: event.Comment.ID, Body: event.Comment.Body, Author: event.Comment.User.Login},
+		}
+		if event.IsPull || event.Issue.PullRequest != nil {
+			webhook.PullRequest = &PullRequest{Number: event.Issue.Number, Title: event.Issue.Title, Author: event.Issue.User.Login}
+			for _, label := range event.Issue.Labels {
+				webhook.PullRequest.Labels = append(webhook.PullRequest.Labels, label.Name)
+			}
+		}
+		return webhook, nil
+	}
+	return nil, ErrUnknownEvent
+}
+
+// giteaAction maps gitea pull request actions to the GitHub ones
+func giteaAction(action string) string {
+	switch action {
+	case "synchronized":
+		return ActionSynchronize
+	case "label_updated":
+		return ActionLabeled
+	case "label_cleared":
+		return ActionUnlabeled
+	}
+	return action
+}
@@ -0,0 +1,605 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/kloops-io/kloops/apis/config/v1alpha1"
+)
+
+type githubHook struct {
+	ID     int64    `json:"id"`
+	Active bool     `json:"active"`
+	Events []string `json:"events"`
+	Config struct {
+		URL string `json:"url"`
+	} `json:"config"`
+	LastResponse struct {
+		Code    *int   `json:"code"`
+		Status  string `json:"status"`
+		Message string `json:"message"`
+	} `json:"last_response"`
+}
+
+// githubHookEvents subscribes GitHub webhooks to all events
+var githubHookEvents = []string{"*"}
+
+// githubPageSize is the page size used when listing GitHub resources
+const githubPageSize = 100
+
+type github struct {
+	httpClient
+	owner string
+	repo  string
+	// app is set when authenticating as a GitHub App installation
+	app *AppTokenSource
+}
+
+func newGitHub(repo *v1alpha1.GitHubRepo, token string) *github {
+	return &github{
+		httpClient: httpClient{baseURL: githubServerURL(repo), authorization: "token " + token},
+		owner:      repo.Owner,
+		repo:       repo.Repo,
+	}
+}
+
+func newGitHubApp(repo *v1alpha1.GitHubRepo, app *AppTokenSource) *github {
+	authorize := func(ctx context.Context) (string, error) {
+		token, err := app.Token(ctx)
+		if err != nil {
+			return "", err
+		}
+		return "token " + token, nil
+	}
+	return &github{
+		httpClient: httpClient{baseURL: githubServerURL(repo), authorize: authorize},
+		owner:      repo.Owner,
+		repo:       repo.Repo,
+		app:        app,
+	}
+}
+
+func githubServerURL(repo *v1alpha1.GitHubRepo) string {
+	if repo.ServerURL == "" {
+		return v1alpha1.DefaultGitHubServerURL
+	}
+	return repo.ServerURL
+}
+
+func (c *github) CurrentUser(ctx context.Context) (string, error) {
+	if c.app != nil {
+		// installation tokens cannot access the user endpoint, the app acts as its bot user
+		return c.app.BotName(ctx)
+	}
+	var user githubUser
+	if err := c.do(ctx, http.MethodGet, "/user", nil, &user); err != nil {
+		return "", err
+	}
+	return user.Login, nil
+}
+
+func (c *github) Permissions(ctx context.Context) (Permissions, error) {
+	if c.app != nil {
+		// the installation permissions only apply to the repositories the app is installed on,
+		// the repository cannot be found with the installation token otherwise
+		if err := c.do(ctx, http.MethodGet, c.repoPath(""), nil, nil); err != nil {
+			if IsNotFound(err) {
+				return Permissions{}, nil
+			}
+			return Permissions{}, err
+		}
+		return c.app.Permissions(ctx)
+	}
+	var repo struct {
+		Permissions Permissions `json:"permissions"`
+	}
+	if err := c.do(ctx, http.MethodGet, c.repoPath(""), nil, &repo); err != nil {
+		return Permissions{}, err
+	}
+	return repo.Permissions, nil
+}
+
+func (c *github) UserPermissions(ctx context.Context, login string) (Permissions, error) {
+	var out struct {
+		Permission string `json:"permission"`
+	}
+	if err := c.do(ctx, http.MethodGet, c.repoPath("/collaborators/"+url.PathEscape(login)+"/permission"), nil, &out); err != nil {
+		if IsNotFound(err) {
+			return Permissions{}, nil
+		}
+		return Permissions{}, err
+	}
+	return roleToPermissions(out.Permission), nil
+}
+
+// githubOrgRepo is a repository returned by the GitHub and Gitea repository listings
+type githubOrgRepo struct {
+	Name     string `json:"name"`
+	Archived bool   `json:"archived"`
+	Owner    struct {
+		Login string `json:"login"`
+	} `json:"owner"`
+}
+
+func (c *github) listOrgRepos(ctx context.Context, owner string) ([]string, error) {
+	if c.app != nil {
+		// installation tokens list the repositories the app is installed on
+		var names []string
+		for page := 1; ; page++ {
+			var out struct {
+				Repositories []githubOrgRepo `json:"repositories"`
+			}
+			if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/installation/repositories?page=%d&per_page=%d", page, githubPageSize), nil, &out); err != nil {
+				return nil, err
+			}
+			for _, repo := range out.Repositories {
+				if strings.EqualFold(repo.Owner.Login, owner) && !repo.Archived {
+					names = append(names, repo.Name)
+				}
+			}
+			if len(out.Repositories) < githubPageSize {
+				return names, nil
+			}
+		}
+	}
+	return listOwnerRepos(ctx, &c.httpClient, owner, "per_page", githubPageSize)
+}
+
+// listOwnerRepos lists the repositories of an organization, or of a user when no organization has that name,
+// using the GitHub flavoured api shared by GitHub and Gitea
+func listOwnerRepos(ctx context.Context, c *httpClient, owner, pageSizeParam string, pageSize int) ([]string, error) {
+	path := "/orgs/" + url.PathEscape(owner) + "/repos"
+	var names []string
+	for page := 1; ; page++ {
+		var repos []githubOrgRepo
+		err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s?page=%d&%s=%d", path, page, pageSizeParam, pageSize), nil, &repos)
+		if IsNotFound(err) && page == 1 && strings.HasPrefix(path, "/orgs/") {
+			path = "/users/" + url.PathEscape(owner) + "/repos"
+			page--
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, repo := range repos {
+			if !repo.Archived {
+				names = append(names, repo.Name)
+			}
+		}
+		if len(repos) < pageSize {
+			return names, nil
+		}
+	}
+}
+
+func (c *github) ListHooks(ctx context.Context) ([]Hook, error) {
+	var hooks []githubHook
+	if err := c.do(ctx, http.MethodGet, c.repoPath("/hooks"), nil, &hooks); err != nil {
+		return nil, err
+	}
+	var out []Hook
+	for _, hook := range hooks {
+		out = append(out, hook.toHook())
+	}
+	return out, nil
+}
+
+func (c *github) repoPath(path string) string {
+	return fmt.Sprintf("/repos/%s/%s%s", url.PathEscape(c.owner), url.PathEscape(c.repo), path)
+}
+
+func (c *github) CreateHook(ctx context.Context, hook HookSpec) (Hook, error) {
+	var out githubHook
+	if err := c.do(ctx, http.MethodPost, c.repoPath("/hooks"), newGitHubHookRequest(hook), &out); err != nil {
+		return Hook{}, err
+	}
+	return out.toHook(), nil
+}
+
+func (c *github) UpdateHook(ctx context.Context, id int64, hook HookSpec) (Hook, error) {
+	var out githubHook
+	if err := c.do(ctx, http.MethodPatch, c.repoPath(fmt.Sprintf("/hooks/%d", id)), newGitHubHookRequest(hook), &out); err != nil {
+		return Hook{}, err
+	}
+	return out.toHook(), nil
+}
+
+func (c *github) DeleteHook(ctx context.Context, id int64) error {
+	return c.do(ctx, http.MethodDelete, c.repoPath(fmt.Sprintf("/hooks/%d", id)), nil, nil)
+}
+
+func (h githubHook) toHook() Hook {
+	hook := Hook{ID: h.ID, URL: h.Config.URL, Active: h.Active, Events: h.Events}
+	// the status is unused until a payload gets delivered
+	if h.LastResponse.Status != "" && h.LastResponse.Status != "unused" {
+		hook.LastDelivery = &HookDelivery{Message: h.LastResponse.Message}
+		if h.LastResponse.Code != nil {
+			hook.LastDelivery.Code = *h.LastResponse.Code
+		}
+	}
+	return hook
+}
+
+func newGitHubHookRequest(hook HookSpec) interface{} {
+	return map[string]interface{}{
+		"name":   "web",
+		"active": true,
+		"events": githubHookEvents,
+		"config": map[string]string{
+			"url":          hook.URL,
+			"content_type": "json",
+			"secret":       hook.Secret,
+			"insecure_ssl": "0",
+		},
+	}
+}
+
+type githubUser struct {
+	Login string `json:"login"`
+}
+
+type githubLabel struct {
+	Name string `json:"name"`
+}
+
+type githubPullRequest struct {
+	Number    int           `json:"number"`
+	Title     string        `json:"title"`
+	Body      string        `json:"body"`
+	State     string        `json:"state"`
+	User      githubUser    `json:"user"`
+	Labels    []githubLabel `json:"labels"`
+	Mergeable *bool         `json:"mergeable"`
+	Merged    bool          `json:"merged"`
+	CreatedAt time.Time     `json:"created_at"`
+	Head      struct {
+		Ref string `json:"ref"`
+		SHA string `json:"sha"`
+	} `json:"head"`
+	Base struct {
+		Ref string `json:"ref"`
+	} `json:"base"`
+}
+
+func (pr *githubPullRequest) toPullRequest() *PullRequest {
+	out := &PullRequest{
+		Number:    pr.Number,
+		Title:     pr.Title,
+		Body:      pr.Body,
+		Author:    pr.User.Login,
+		HeadRef:   pr.Head.Ref,
+		HeadSHA:   pr.Head.SHA,
+		BaseRef:   pr.Base.Ref,
+		Mergeable: pr.Mergeable,
+		Merged:    pr.Merged,
+		Closed:    pr.State == "closed",
+		Created:   pr.CreatedAt,
+	}
+	for _, label := range pr.Labels {
+		out.Labels = append(out.Labels, label.Name)
+	}
+	return out
+}
+
+type githubRepository struct {
+	FullName string `json:"full_name"`
+}
+
+type githubComment struct {
+	ID   int64      `json:"id"`
+	Body string     `json:"body"`
+	User githubUser `json:"user"`
+}
+
+type githubIssue struct {
+	Number      int           `json:"number"`
+	Title       string        `json:"title"`
+	User        githubUser    `json:"user"`
+	PullRequest *struct{}     `json:"pull_request"`
+	Labels      []githubLabel `json:"labels"`
+}
+
+func (c *github) CreateComment(ctx context.Context, number int, body string) error {
+	return c.do(ctx, http.MethodPost, c.repoPath(fmt.Sprintf("/issues/%d/comments", number)), map[string]string{"body": body}, nil)
+}
+
+func (c *github) AddLabel(ctx context.Context, number int, label string) error {
+	return c.do(ctx, http.MethodPost, c.repoPath(fmt.Sprintf("/issues/%d/labels", number)), map[string][]string{"labels": {label}}, nil)
+}
+
+func (c *github) RemoveLabel(ctx context.Context, number int, label string) error {
+	err := c.do(ctx, http.MethodDelete, c.repoPath(fmt.Sprintf("/issues/%d/labels/%s", number, url.PathEscape(label))), nil, nil)
+	if IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (c *github) ListLabels(ctx context.Context) ([]Label, error) {
+	var result []Label
+	for page := 1; ; page++ {
+		var labels []struct {
+			Name        string `json:"name"`
+			Color       string `json:"color"`
+			Description string `json:"description"`
+		}
+		if err := c.do(ctx, http.MethodGet, c.repoPath(fmt.Sprintf("/labels?page=%d&per_page=%d", page, githubPageSize)), nil, &labels); err != nil {
+			return nil, err
+		}
+		for _, label := range labels {
+			result = append(result, Label{Name: label.Name, Color: label.Color, Description: label.Description})
+		}
+		if len(labels) < githubPageSize {
+			return result, nil
+		}
+	}
+}
+
+func (c *github) CreateLabel(ctx context.Context, label Label) error {
+	req := map[string]string{
+		"name":        label.Name,
+		"color":       label.Color,
+		"description": label.Description,
+	}
+	return c.do(ctx, http.MethodPost, c.repoPath("/labels"), req, nil)
+}
+
+func (c *github) UpdateLabel(ctx context.Context, name string, label Label) error {
+	req := map[string]string{
+		"new_name":    label.Name,
+		"color":       label.Color,
+		"description": label.Description,
+	}
+	return c.do(ctx, http.MethodPatch, c.repoPath("/labels/"+url.PathEscape(name)), req, nil)
+}
+
+func (c *github) DeleteLabel(ctx context.Context, name string) error {
+	return c.do(ctx, http.MethodDelete, c.repoPath("/labels/"+url.PathEscape(name)), nil, nil)
+}
+
+func (c *github) CreateStatus(ctx context.Context, sha string, status Status) error {
+	req := map[string]string{
+		"state":       string(status.State),
+		"context":     status.Context,
+		"description": status.Description,
+		"target_url":  status.TargetURL,
+	}
+	return c.do(ctx, http.MethodPost, c.repoPath("/statuses/"+sha), req, nil)
+}
+
+func (c *github) ListStatuses(ctx context.Context, ref string) ([]Status, error) {
+	var combined struct {
+		Statuses []githubStatus `json:"statuses"`
+	}
+	if err := c.do(ctx, http.MethodGet, c.repoPath("/commits/"+url.PathEscape(ref)+"/status"), nil, &combined); err != nil {
+		return nil, err
+	}
+	var out []Status
+	for _, status := range combined.Statuses {
+		out = append(out, status.toStatus())
+	}
+	return out, nil
+}
+
+// githubStatus is a GitHub or Gitea commit status
+type githubStatus struct {
+	State       string `json:"state"`
+	Status      string `json:"status"`
+	Context     string `json:"context"`
+	Description string `json:"description"`
+	TargetURL   string `json:"target_url"`
+}
+
+func (s *githubStatus) toStatus() Status {
+	state := s.State
+	if state == "" {
+		// gitea names the state of the statuses of a combined status "status"
+		state = s.Status
+	}
+	return Status{State: StatusState(state), Context: s.Context, Description: s.Description, TargetURL: s.TargetURL}
+}
+
+func (c *github) GetBranchSHA(ctx context.Context, branch string) (string, error) {
+	var out struct {
+		Commit struct {
+			SHA string `json:"sha"`
+		} `json:"commit"`
+	}
+	if err := c.do(ctx, http.MethodGet, c.repoPath("/branches/"+url.PathEscape(branch)), nil, &out); err != nil {
+		return "", err
+	}
+	return out.Commit.SHA, nil
+}
+
+func (c *github) GetPullRequest(ctx context.Context, number int) (*PullRequest, error) {
+	var pr githubPullRequest
+	if err := c.do(ctx, http.MethodGet, c.repoPath(fmt.Sprintf("/pulls/%d", number)), nil, &pr); err != nil {
+		return nil, err
+	}
+	return pr.toPullRequest(), nil
+}
+
+func (c *github) Merge(ctx context.Context, number int, options MergeOptions) error {
+	req := map[string]string{
+		"merge_method": string(options.Method),
+		"sha":          options.SHA,
+	}
+	if options.CommitTitle != "" {
+		req["commit_title"] = options.CommitTitle
+	}
+	if options.CommitMessage != "" {
+		req["commit_message"] = options.CommitMessage
+	}
+	return c.do(ctx, http.MethodPut, c.repoPath(fmt.Sprintf("/pulls/%d/merge", number)), req, nil)
+}
+
+func (c *github) UpdateBranch(ctx context.Context, number int, method v1alpha1.UpdateBranchMethod) error {
+	if method != v1alpha1.UpdateBranchMerge {
+		// the REST API only merges the base branch into the pull request branch
+		return fmt.Errorf("%s branch update: %w", method, ErrUnsupported)
+	}
+	return c.do(ctx, http.MethodPut, c.repoPath(fmt.Sprintf("/pulls/%d/update-branch", number)), map[string]string{}, nil)
+}
+
+type githubBranchProtection struct {
+	RequiredStatusChecks *struct {
+		Contexts []string `json:"contexts"`
+	} `json:"required_status_checks"`
+	RequiredPullRequestReviews *struct {
+		DismissStaleReviews          bool `json:"dismiss_stale_reviews"`
+		RequiredApprovingReviewCount int  `json:"required_approving_review_count"`
+	} `json:"required_pull_request_reviews"`
+	EnforceAdmins struct {
+		Enabled bool `json:"enabled"`
+	} `json:"enforce_admins"`
+	Restrictions *struct {
+		Teams []struct {
+			Slug string `json:"slug"`
+		} `json:"teams"`
+	} `json:"restrictions"`
+}
+
+func (c *github) GetBranchProtection(ctx context.Context, branch string) (*BranchProtection, error) {
+	var protection githubBranchProtection
+	if err := c.do(ctx, http.MethodGet, c.repoPath("/branches/"+url.PathEscape(branch)+"/protection"), nil, &protection); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	result := &BranchProtection{EnforceAdmins: protection.EnforceAdmins.Enabled}
+	if protection.RequiredStatusChecks != nil {
+		result.RequiredStatusContexts = protection.RequiredStatusChecks.Contexts
+	}
+	if protection.RequiredPullRequestReviews != nil {
+		result.RequiredApprovals = protection.RequiredPullRequestReviews.RequiredApprovingReviewCount
+		result.DismissStaleReviews = protection.RequiredPullRequestReviews.DismissStaleReviews
+	}
+	if protection.Restrictions != nil {
+		for _, team := range protection.Restrictions.Teams {
+			result.PushTeams = append(result.PushTeams, team.Slug)
+		}
+	}
+	return result, nil
+}
+
+func (c *github) UpdateBranchProtection(ctx context.Context, branch string, protection BranchProtection) error {
+	// GitHub requires all the keys to be present, null disables a rule
+	req := map[string]interface{}{
+		"required_status_checks":        nil,
+		"enforce_admins":                protection.EnforceAdmins,
+		"required_pull_request_reviews": nil,
+		"restrictions":                  nil,
+	}
+	if len(protection.RequiredStatusContexts) != 0 {
+		req["required_status_checks"] = map[string]interface{}{
+			"strict":   false,
+			"contexts": protection.RequiredStatusContexts,
+		}
+	}
+	if protection.RequiredApprovals > 0 || protection.DismissStaleReviews {
+		req["required_pull_request_reviews"] = map[string]interface{}{
+			"dismiss_stale_reviews":           protection.DismissStaleReviews,
+			"required_approving_review_count": protection.RequiredApprovals,
+		}
+	}
+	if len(protection.PushTeams) != 0 {
+		req["restrictions"] = map[string]interface{}{
+			"users": []string{},
+			"teams": protection.PushTeams,
+		}
+	}
+	return c.do(ctx, http.MethodPut, c.repoPath("/branches/"+url.PathEscape(branch)+"/protection"), req, nil)
+}
+
+func (c *github) GetFile(ctx context.Context, path, ref string) ([]byte, error) {
+	return getContentsFile(ctx, &c.httpClient, c.repoPath, path, ref)
+}
+
+func (c *github) ListFiles(ctx context.Context, dir, ref string) ([]string, error) {
+	return listContentsFiles(ctx, &c.httpClient, c.repoPath, dir, ref)
+}
+
+func (c *github) ParseWebhook(req *http.Request, secret string) (*Webhook, error) {
+	payload, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateGitHubSignature(req, secret, payload); err != nil {
+		return nil, err
+	}
+	switch req.Header.Get("X-GitHub-Event") {
+	case "push":
+		var event struct {
+			Ref        string           `json:"ref"`
+			Before     string           `json:"before"`
+			After      string           `json:"after"`
+			Repository githubRepository `json:"repository"`
+			Sender     githubUser       `json:"sender"`
+		}
+		if err := json.Unmarshal(payload, &event); err != nil {
+			return nil, err
+		}
+		return &Webhook{Kind: WebhookPush, Repo: event.Repository.FullName, Sender: event.Sender.Login, Ref: event.Ref, Before: event.Before, After: event.After}, nil
+	case "pull_request":
+		var event struct {
+			Action      string            `json:"action"`
+			PullRequest githubPullRequest `json:"pull_request"`
+			Repository  githubRepository  `json:"repository"`
+			Sender      githubUser        `json:"sender"`
+		}
+		if err := json.Unmarshal(payload, &event); err != nil {
+			return nil, err
+		}
+		pr := event.PullRequest.toPullRequest()
+		return &Webhook{Kind: WebhookPullRequest, Action: event.Action, Repo: event.Repository.FullName, Sender: event.Sender.Login, Number: pr.Number, PullRequest: pr}, nil
+	case "issue_comment":
+		var event struct {
+			Action     string           `json:"action"`
+			Issue      githubIssue      `json:"issue"`
+			Comment    githubComment    `json:"comment"`
+			Repository githubRepository `json:"repository"`
+			Sender     githubUser       `json:"sender"`
+		}
+		if err := json.Unmarshal(payload, &event); err != nil {
+			return nil, err
+		}
+		webhook := &Webhook{
+			Kind:    WebhookComment,
+			Action:  event.Action,
+			Repo:    event.Repository.FullName,
+			Sender:  event.Sender.Login,
+			Number:  event.Issue.Number,
+			Comment: &Comment{ID: event.Comment.ID, Body: event.Comment.Body, Author: event.Comment.User.Login},
+		}
+		if event.Issue.PullRequest != nil {
+			webhook.PullRequest = &PullRequest{Number: event.Issue.Number, Title: event.Issue.Title, Author: event.Issue.User.Login}
+			for _, label := range event.Issue.Labels {
+				webhook.PullRequest.Labels = append(webhook.PullRequest.Labels, label.Name)
+			}
+		}
+		return webhook, nil
+	}
+	return nil, ErrUnknownEvent
+}
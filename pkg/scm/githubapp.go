@@ -0,0 +1,207 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scm
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// appJWTLifetime is the lifetime of the JWTs used to authenticate as the app, GitHub allows 10 minutes at most
+	appJWTLifetime = 9 * time.Minute
+	// appTokenRefreshMargin is how long before expiry an installation token is refreshed
+	appTokenRefreshMargin = 5 * time.Minute
+)
+
+// AppTokenSource mints GitHub App installation tokens and caches them until they are about to expire
+type AppTokenSource struct {
+	serverURL      string
+	appID          int64
+	installationID int64
+	privateKey     *rsa.PrivateKey
+	now            func() time.Time
+
+	mu      sync.Mutex
+	token   string
+	expiry  time.Time
+	botName string
+}
+
+// NewAppTokenSource creates a token source for the given app installation
+func NewAppTokenSource(serverURL string, appID, installationID int64, privateKey []byte) (*AppTokenSource, error) {
+	key, err := parsePrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return &AppTokenSource{
+		serverURL:      serverURL,
+		appID:          appID,
+		installationID: installationID,
+		privateKey:     key,
+		now:            time.Now,
+	}, nil
+}
+
+// Token returns a valid installation token, minting a new one when the cached token is about to expire
+func (s *AppTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != "" && s.now().Add(appTokenRefreshMargin).Before(s.expiry) {
+		return s.token, nil
+	}
+	var out struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+	if err := s.appClient().do(ctx, http.MethodPost, fmt.Sprintf("/app/installations/%d/access_tokens", s.installationID), nil, &out); err != nil {
+		return "", fmt.Errorf("failed to create installation token: %w", err)
+	}
+	s.token, s.expiry = out.Token, out.ExpiresAt
+	return s.token, nil
+}
+
+// BotName returns the login of the app bot user
+func (s *AppTokenSource) BotName(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.botName != "" {
+		return s.botName, nil
+	}
+	var app struct {
+		Slug string `json:"slug"`
+	}
+	if err := s.appClient().do(ctx, http.MethodGet, "/app", nil, &app); err != nil {
+		return "", fmt.Errorf("failed to get app: %w", err)
+	}
+	s.botName = app.Slug + "[bot]"
+	return s.botName, nil
+}
+
+// Permissions returns the permissions granted to the app installation
+func (s *AppTokenSource) Permissions(ctx context.Context) (Permissions, error) {
+	var installation struct {
+		Permissions map[string]string `json:"permissions"`
+	}
+	if err := s.appClient().do(ctx, http.MethodGet, fmt.Sprintf("/app/installations/%d", s.installationID), nil, &installation); err != nil {
+		return Permissions{}, fmt.Errorf("failed to get installation: %w", err)
+	}
+	return Permissions{
+		Admin: installation.Permissions["administration"] == "write",
+		Push:  installation.Permissions["contents"] == "write",
+		Pull:  installation.Permissions["contents"] != "",
+	}, nil
+}
+
+// appClient returns a client authenticated as the app itself
+func (s *AppTokenSource) appClient() *httpClient {
+	return &httpClient{
+		baseURL: s.serverURL,
+		authorize: func(context.Context) (string, error) {
+			jwt, err := s.jwt()
+			if err != nil {
+				return "", err
+			}
+			return "Bearer " + jwt, nil
+		},
+	}
+}
+
+// jwt creates the RS256 signed JWT used to authenticate as the app
+func (s *AppTokenSource) jwt() (string, error) {
+	now := s.now()
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]int64{
+		// backdate the token to allow for clock drift
+		"iat": now.Add(-time.Minute).Unix(),
+		"exp": now.Add(appJWTLifetime).Unix(),
+		"iss": s.appID,
+	})
+	if err != nil {
+		return "", err
+	}
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	hashed := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.privateKey, crypto.SHA256, hashed[:])
+	if err != nil {
+		return "", err
+	}
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func parsePrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("private key is not PEM encoded")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("private key is not a RSA key")
+	}
+	return rsaKey, nil
+}
+
+type appTokenSourceKey struct {
+	serverURL      string
+	appID          int64
+	installationID int64
+}
+
+var (
+	appTokenSourcesMu sync.Mutex
+	appTokenSources   = map[appTokenSourceKey]*AppTokenSource{}
+)
+
+// appTokenSourceFor returns the shared token source of an app installation, so that tokens are cached across clients
+func appTokenSourceFor(serverURL string, appID, installationID int64, privateKey []byte) (*AppTokenSource, error) {
+	key := appTokenSourceKey{serverURL: serverURL, appID: appID, installationID: installationID}
+	appTokenSourcesMu.Lock()
+	defer appTokenSourcesMu.Unlock()
+	if source, ok := appTokenSources[key]; ok {
+		if parsed, err := parsePrivateKey(privateKey); err == nil && parsed.N.Cmp(source.privateKey.N) == 0 && parsed.D.Cmp(source.privateKey.D) == 0 {
+			return source, nil
+		}
+	}
+	source, err := NewAppTokenSource(serverURL, appID, installationID, privateKey)
+	if err != nil {
+		return nil, err
+	}
+	appTokenSources[key] = source
+	return source, nil
+}
@@ -0,0 +1,251 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scm
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kloops-io/kloops/apis/config/v1alpha1"
+)
+
+// fakeGitHubApp is a fake GitHub api answering the app and installation requests
+type fakeGitHubApp struct {
+	t          *testing.T
+	key        *rsa.PrivateKey
+	tokens     int
+	expiry     time.Time
+	repos      map[string]bool
+	permission map[string]string
+}
+
+func (f *fakeGitHubApp) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	authorization := req.Header.Get("Authorization")
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case strings.HasPrefix(req.URL.Path, "/app"):
+		if !strings.HasPrefix(authorization, "Bearer ") {
+			f.t.Errorf("app request %s not authenticated with a JWT: %q", req.URL.Path, authorization)
+		} else if err := verifyJWT(f.key, strings.TrimPrefix(authorization, "Bearer ")); err != nil {
+			f.t.Errorf("invalid JWT: %v", err)
+		}
+	default:
+		if want := fmt.Sprintf("token token-%d", f.tokens); authorization != want {
+			f.t.Errorf("unexpected authorization %q, want %q", authorization, want)
+		}
+	}
+	switch route := req.Method + " " + req.URL.Path; {
+	case route == "POST /app/installations/5/access_tokens":
+		f.tokens++
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"token": fmt.Sprintf("token-%d", f.tokens), "expires_at": f.expiry})
+	case route == "GET /app/installations/5":
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"permissions": f.permission})
+	case route == "GET /app":
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"slug": "kloops"})
+	case strings.HasPrefix(route, "GET /repos/") && f.repos[strings.TrimPrefix(route, "GET /repos/")]:
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// verifyJWT checks the RS256 signature and the claims of an app JWT
+func verifyJWT(key *rsa.PrivateKey, jwt string) error {
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("malformed JWT %s", jwt)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return err
+	}
+	hashed := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, hashed[:], signature); err != nil {
+		return err
+	}
+	var header map[string]string
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return err
+	}
+	if header["alg"] != "RS256" || header["typ"] != "JWT" {
+		return fmt.Errorf("unexpected header %v", header)
+	}
+	return nil
+}
+
+func decodeSegment(segment string, out interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+func newTestKey(t *testing.T) (*rsa.PrivateKey, []byte) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+}
+
+func newFakeGitHubApp(t *testing.T) (*fakeGitHubApp, *AppTokenSource) {
+	key, data := newTestKey(t)
+	f := &fakeGitHubApp{t: t, key: key}
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+	source, err := NewAppTokenSource(server.URL, 42, 5, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f, source
+}
+
+func TestAppTokenSourceJWT(t *testing.T) {
+	_, source := newFakeGitHubApp(t)
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	source.now = func() time.Time { return now }
+
+	jwt, err := source.jwt()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verifyJWT(source.privateKey, jwt); err != nil {
+		t.Fatal(err)
+	}
+	var claims map[string]int64
+	if err := decodeSegment(strings.Split(jwt, ".")[1], &claims); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]int64{"iss": 42, "iat": now.Add(-time.Minute).Unix(), "exp": now.Add(appJWTLifetime).Unix()}
+	for claim, value := range want {
+		if claims[claim] != value {
+			t.Errorf("claim %s = %d, want %d", claim, claims[claim], value)
+		}
+	}
+}
+
+func TestAppTokenSourceToken(t *testing.T) {
+	f, source := newFakeGitHubApp(t)
+	ctx := context.Background()
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	source.now = func() time.Time { return now }
+	f.expiry = now.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		advance time.Duration
+		want    string
+	}{
+		{name: "mints a token", want: "token-1"},
+		{name: "caches the token", advance: 30 * time.Minute, want: "token-1"},
+		{name: "keeps the token until the refresh margin", advance: 24 * time.Minute, want: "token-1"},
+		{name: "refreshes the token before it expires", advance: 2 * time.Minute, want: "token-2"},
+		{name: "caches the refreshed token", advance: 10 * time.Minute, want: "token-2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now = now.Add(tt.advance)
+			if tt.want != "token-1" {
+				// the next token expires an hour after it is minted
+				f.expiry = now.Add(time.Hour)
+			}
+			got, err := source.Token(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Token() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGitHubAppPermissions(t *testing.T) {
+	tests := []struct {
+		name       string
+		repo       string
+		permission map[string]string
+		want       Permissions
+	}{{
+		name:       "installed on the repository",
+		repo:       "my-org/my-repo",
+		permission: map[string]string{"contents": "write", "pull_requests": "write"},
+		want:       Permissions{Push: true, Pull: true},
+	}, {
+		name:       "admin",
+		repo:       "my-org/my-repo",
+		permission: map[string]string{"contents": "write", "administration": "write"},
+		want:       Permissions{Admin: true, Push: true, Pull: true},
+	}, {
+		name:       "not installed on the repository",
+		repo:       "my-org/other",
+		permission: map[string]string{"contents": "write", "administration": "write"},
+		want:       Permissions{},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, source := newFakeGitHubApp(t)
+			f.expiry = time.Now().Add(time.Hour)
+			f.repos = map[string]bool{"my-org/my-repo": true}
+			f.permission = tt.permission
+			parts := strings.Split(tt.repo, "/")
+			c := newGitHubApp(&v1alpha1.GitHubRepo{ServerURL: source.serverURL, Owner: parts[0], Repo: parts[1]}, source)
+			got, err := c.Permissions(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Permissions() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	key, pkcs1 := newTestKey(t)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkcs8 := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	for name, data := range map[string][]byte{"pkcs1": pkcs1, "pkcs8": pkcs8} {
+		parsed, err := parsePrivateKey(data)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if parsed.N.Cmp(key.N) != 0 {
+			t.Errorf("%s: parsed another key", name)
+		}
+	}
+	if _, err := parsePrivateKey([]byte("not a key")); err == nil {
+		t.Error("expected an error for a non PEM key")
+	}
+}
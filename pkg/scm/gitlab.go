@@ -0,0 +1,589 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/kloops-io/kloops/apis/config/v1alpha1"
+)
+
+// gitlabDeveloperAccess is the minimum access level allowing to push to a project
+const gitlabDeveloperAccess = 30
+
+// gitlabMaintainerAccess is the minimum access level allowing to administrate a project
+const gitlabMaintainerAccess = 40
+
+type gitlabUser struct {
+	Username string `json:"username"`
+}
+
+type gitlabLabel struct {
+	Title string `json:"title"`
+}
+
+type gitlabHook struct {
+	ID  int64  `json:"id"`
+	URL string `json:"url"`
+}
+
+func (h gitlabHook) toHook() Hook {
+	// gitlab hooks cannot be disabled
+	return Hook{ID: h.ID, URL: h.URL, Active: true}
+}
+
+type gitlabMergeRequest struct {
+	IID          int        `json:"iid"`
+	Title        string     `json:"title"`
+	Description  string     `json:"description"`
+	State        string     `json:"state"`
+	Author       gitlabUser `json:"author"`
+	SourceBranch string     `json:"source_branch"`
+	TargetBranch string     `json:"target_branch"`
+	SHA          string     `json:"sha"`
+	Labels       []string   `json:"labels"`
+	MergeStatus  string     `json:"merge_status"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+func (mr *gitlabMergeRequest) toPullRequest() *PullRequest {
+	out := &PullRequest{
+		Number:  mr.IID,
+		Title:   mr.Title,
+		Body:    mr.Description,
+		Author:  mr.Author.Username,
+		HeadRef: mr.SourceBranch,
+		HeadSHA: mr.SHA,
+		BaseRef: mr.TargetBranch,
+		Labels:  mr.Labels,
+		Merged:  mr.State == "merged",
+		Closed:  mr.State == "closed" || mr.State == "merged",
+		Created: mr.CreatedAt,
+	}
+	switch mr.MergeStatus {
+	case "can_be_merged":
+		mergeable := true
+		out.Mergeable = &mergeable
+	case "cannot_be_merged":
+		mergeable := false
+		out.Mergeable = &mergeable
+	}
+	return out
+}
+
+// gitlabRebasePollInterval is the interval at which merge requests are polled while rebasing
+const gitlabRebasePollInterval = time.Second
+
+// gitlabRebaseTimeout is the maximum duration waited for a merge request rebase
+const gitlabRebaseTimeout = 2 * time.Minute
+
+// gitlabPageSize is the page size used when listing GitLab resources
+const gitlabPageSize = 100
+
+type gitlab struct {
+	httpClient
+	project string
+	// rebasePollInterval overrides gitlabRebasePollInterval when set
+	rebasePollInterval time.Duration
+}
+
+func newGitLab(repo *v1alpha1.GitLabRepo, token string) *gitlab {
+	server := repo.ServerURL
+	if server == "" {
+		server = v1alpha1.DefaultGitLabServerURL
+	}
+	return &gitlab{
+		httpClient: httpClient{baseURL: strings.TrimSuffix(server, "/") + "/api/v4", authorization: "Bearer " + token},
+		project:    repo.Project,
+	}
+}
+
+func (c *gitlab) projectPath(path string) string {
+	return "/projects/" + url.PathEscape(c.project) + path
+}
+
+func (c *gitlab) listOrgRepos(ctx context.Context, group string) ([]string, error) {
+	var names []string
+	for page := 1; ; page++ {
+		var projects []struct {
+			Path string `json:"path"`
+		}
+		// projects of subgroups are not listed, org configs do not match them
+		if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/groups/%s/projects?archived=false&page=%d&per_page=%d", url.PathEscape(group), page, gitlabPageSize), nil, &projects); err != nil {
+			return nil, err
+		}
+		for _, project := range projects {
+			names = append(names, project.Path)
+		}
+		if len(projects) < gitlabPageSize {
+			return names, nil
+		}
+	}
+}
+
+func (c *gitlab) CurrentUser(ctx context.Context) (string, error) {
+	var user gitlabUser
+	if err := c.do(ctx, http.MethodGet, "/user", nil, &user); err != nil {
+		return "", err
+	}
+	return user.Username, nil
+}
+
+func (c *gitlab) Permissions(ctx context.Context) (Permissions, error) {
+	type access struct {
+		AccessLevel int `json:"access_level"`
+	}
+	var project struct {
+		Permissions struct {
+			ProjectAccess *access `json:"project_access"`
+			GroupAccess   *access `json:"group_access"`
+		} `json:"permissions"`
+	}
+	if err := c.do(ctx, http.MethodGet, c.projectPath(""), nil, &project); err != nil {
+		return Permissions{}, err
+	}
+	level := 0
+	if a := project.Permissions.ProjectAccess; a != nil && a.AccessLevel > level {
+		level = a.AccessLevel
+	}
+	if a := project.Permissions.GroupAccess; a != nil && a.AccessLevel > level {
+		level = a.AccessLevel
+	}
+	return Permissions{
+		Admin: level >= gitlabMaintainerAccess,
+		Push:  level >= gitlabDeveloperAccess,
+		Pull:  level > 0,
+	}, nil
+}
+
+func (c *gitlab) UserPermissions(ctx context.Context, login string) (Permissions, error) {
+	var users []struct {
+		ID int64 `json:"id"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/users?username="+url.QueryEscape(login), nil, &users); err != nil {
+		return Permissions{}, err
+	}
+	if len(users) == 0 {
+		return Permissions{}, nil
+	}
+	// members/all includes the members inherited from the parent groups
+	var member struct {
+		AccessLevel int `json:"access_level"`
+	}
+	if err := c.do(ctx, http.MethodGet, c.projectPath(fmt.Sprintf("/members/all/%d", users[0].ID)), nil, &member); err != nil {
+		if IsNotFound(err) {
+			return Permissions{}, nil
+		}
+		return Permissions{}, err
+	}
+	return Permissions{
+		Admin: member.AccessLevel >= gitlabMaintainerAccess,
+		Push:  member.AccessLevel >= gitlabDeveloperAccess,
+		Pull:  member.AccessLevel > 0,
+	}, nil
+}
+
+func (c *gitlab) ListHooks(ctx context.Context) ([]Hook, error) {
+	var hooks []gitlabHook
+	if err := c.do(ctx, http.MethodGet, c.projectPath("/hooks"), nil, &hooks); err != nil {
+		return nil, err
+	}
+	var out []Hook
+	for _, hook := range hooks {
+		out = append(out, hook.toHook())
+	}
+	return out, nil
+}
+
+func (c *gitlab) CreateHook(ctx context.Context, hook HookSpec) (Hook, error) {
+	var out gitlabHook
+	if err := c.do(ctx, http.MethodPost, c.projectPath("/hooks"), newGitLabHookRequest(hook), &out); err != nil {
+		return Hook{}, err
+	}
+	return out.toHook(), nil
+}
+
+func (c *gitlab) UpdateHook(ctx context.Context, id int64, hook HookSpec) (Hook, error) {
+	var out gitlabHook
+	if err := c.do(ctx, http.MethodPut, c.projectPath(fmt.Sprintf("/hooks/%d", id)), newGitLabHookRequest(hook), &out); err != nil {
+		return Hook{}, err
+	}
+	return out.toHook(), nil
+}
+
+func (c *gitlab) DeleteHook(ctx context.Context, id int64) error {
+	return c.do(ctx, http.MethodDelete, c.projectPath(fmt.Sprintf("/hooks/%d", id)), nil, nil)
+}
+
+func newGitLabHookRequest(hook HookSpec) interface{} {
+	return map[string]interface{}{
+		"url":                     hook.URL,
+		"token":                   hook.Secret,
+		"push_events":             true,
+		"merge_requests_events":   true,
+		"note_events":             true,
+		"issues_events":           true,
+		"enable_ssl_verification": true,
+	}
+}
+
+func (c *gitlab) CreateComment(ctx context.Context, number int, body string) error {
+	return c.do(ctx, http.MethodPost, c.projectPath(fmt.Sprintf("/merge_requests/%d/notes", number)), map[string]string{"body": body}, nil)
+}
+
+func (c *gitlab) AddLabel(ctx context.Context, number int, label string) error {
+	return c.do(ctx, http.MethodPut, c.projectPath(fmt.Sprintf("/merge_requests/%d", number)), map[string]string{"add_labels": label}, nil)
+}
+
+func (c *gitlab) RemoveLabel(ctx context.Context, number int, label string) error {
+	return c.do(ctx, http.MethodPut, c.projectPath(fmt.Sprintf("/merge_requests/%d", number)), map[string]string{"remove_labels": label}, nil)
+}
+
+func (c *gitlab) CreateStatus(ctx context.Context, sha string, status Status) error {
+	req := map[string]string{
+		"state":       gitlabState(status.State),
+		"name":        status.Context,
+		"description": status.Description,
+		"target_url":  status.TargetURL,
+	}
+	return c.do(ctx, http.MethodPost, c.projectPath("/statuses/"+sha), req, nil)
+}
+
+func (c *gitlab) ListStatuses(ctx context.Context, ref string) ([]Status, error) {
+	var statuses []struct {
+		Name        string `json:"name"`
+		Status      string `json:"status"`
+		Description string `json:"description"`
+		TargetURL   string `json:"target_url"`
+	}
+	// statuses are listed most recent first, the latest status of each name is kept
+	if err := c.do(ctx, http.MethodGet, c.projectPath("/repository/commits/"+url.PathEscape(ref)+"/statuses?per_page=100"), nil, &statuses); err != nil {
+		return nil, err
+	}
+	var out []Status
+	seen := map[string]bool{}
+	for _, status := range statuses {
+		if seen[status.Name] {
+			continue
+		}
+		seen[status.Name] = true
+		out = append(out, Status{State: gitlabStatusState(status.Status), Context: status.Name, Description: status.Description, TargetURL: status.TargetURL})
+	}
+	return out, nil
+}
+
+// gitlabStatusState maps gitlab commit status states to the commit status states
+func gitlabStatusState(state string) StatusState {
+	switch state {
+	case "success":
+		return StatusSuccess
+	case "failed":
+		return StatusFailure
+	case "canceled":
+		return StatusError
+	}
+	return StatusPending
+}
+
+func (c *gitlab) GetBranchSHA(ctx context.Context, branch string) (string, error) {
+	var out struct {
+		Commit struct {
+			ID string `json:"id"`
+		} `json:"commit"`
+	}
+	if err := c.do(ctx, http.MethodGet, c.projectPath("/repository/branches/"+url.PathEscape(branch)), nil, &out); err != nil {
+		return "", err
+	}
+	return out.Commit.ID, nil
+}
+
+// gitlabState maps commit status states to the gitlab ones
+func gitlabState(state StatusState) string {
+	switch state {
+	case StatusFailure, StatusError:
+		return "failed"
+	}
+	return string(state)
+}
+
+func (c *gitlab) GetPullRequest(ctx context.Context, number int) (*PullRequest, error) {
+	var mr gitlabMergeRequest
+	if err := c.do(ctx, http.MethodGet, c.projectPath(fmt.Sprintf("/merge_requests/%d", number)), nil, &mr); err != nil {
+		return nil, err
+	}
+	return mr.toPullRequest(), nil
+}
+
+func (c *gitlab) Merge(ctx context.Context, number int, options MergeOptions) error {
+	req := map[string]interface{}{}
+	switch options.Method {
+	case v1alpha1.MergeSquash:
+		req["squash"] = true
+		if options.CommitTitle != "" || options.CommitMessage != "" {
+			req["squash_commit_message"] = strings.TrimSpace(options.CommitTitle + "\n\n" + options.CommitMessage)
+		}
+	case v1alpha1.MergeMerge:
+		if options.CommitTitle != "" || options.CommitMessage != "" {
+			req["merge_commit_message"] = strings.TrimSpace(options.CommitTitle + "\n\n" + options.CommitMessage)
+		}
+	case v1alpha1.MergeRebase:
+		// rebase merges are a project setting on gitlab, the merge request is rebased then merged
+		sha, err := c.rebase(ctx, number, options.SHA)
+		if err != nil {
+			return err
+		}
+		options.SHA = sha
+	default:
+		return fmt.Errorf("%s merge: %w", options.Method, ErrUnsupported)
+	}
+	if options.SHA != "" {
+		req["sha"] = options.SHA
+	}
+	return c.do(ctx, http.MethodPut, c.projectPath(fmt.Sprintf("/merge_requests/%d/merge", number)), req, nil)
+}
+
+// rebase rebases a merge request on its target branch and waits for the rebase to complete.
+// The rebase fails if the merge request head is not the given sha (when set), the rebased head sha is returned.
+func (c *gitlab) rebase(ctx context.Context, number int, sha string) (string, error) {
+	if sha != "" {
+		pr, err := c.GetPullRequest(ctx, number)
+		if err != nil {
+			return "", err
+		}
+		if pr.HeadSHA != sha {
+			return "", fmt.Errorf("merge request %d head moved from %s to %s", number, sha, pr.HeadSHA)
+		}
+	}
+	if err := c.do(ctx, http.MethodPut, c.projectPath(fmt.Sprintf("/merge_requests/%d/rebase", number)), nil, nil); err != nil {
+		return "", fmt.Errorf("failed to rebase merge request %d: %w", number, err)
+	}
+	interval := c.rebasePollInterval
+	if interval <= 0 {
+		interval = gitlabRebasePollInterval
+	}
+	ctx, cancel := context.WithTimeout(ctx, gitlabRebaseTimeout)
+	defer cancel()
+	for {
+		var mr struct {
+			SHA              string `json:"sha"`
+			RebaseInProgress bool   `json:"rebase_in_progress"`
+			MergeError       string `json:"merge_error"`
+		}
+		if err := c.do(ctx, http.MethodGet, c.projectPath(fmt.Sprintf("/merge_requests/%d?include_rebase_in_progress=true", number)), nil, &mr); err != nil {
+			return "", err
+		}
+		if !mr.RebaseInProgress {
+			if mr.MergeError != "" {
+				return "", fmt.Errorf("failed to rebase merge request %d: %s", number, mr.MergeError)
+			}
+			return mr.SHA, nil
+		}
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("merge request %d rebase did not complete: %w", number, ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
+
+func (c *gitlab) UpdateBranch(ctx context.Context, number int, method v1alpha1.UpdateBranchMethod) error {
+	if method != v1alpha1.UpdateBranchRebase {
+		// gitlab can only rebase merge request branches
+		return fmt.Errorf("%s branch update: %w", method, ErrUnsupported)
+	}
+	return c.do(ctx, http.MethodPut, c.projectPath(fmt.Sprintf("/merge_requests/%d/rebase", number)), nil, nil)
+}
+
+type gitlabProject struct {
+	PathWithNamespace string `json:"path_with_namespace"`
+}
+
+type gitlabHookMergeRequest struct {
+	IID          int    `json:"iid"`
+	Title        string `json:"title"`
+	Description  string `json:"description"`
+	State        string `json:"state"`
+	Action       string `json:"action"`
+	SourceBranch string `json:"source_branch"`
+	TargetBranch string `json:"target_branch"`
+	OldRev       string `json:"oldrev"`
+	LastCommit   struct {
+		ID string `json:"id"`
+	} `json:"last_commit"`
+}
+
+func (mr *gitlabHookMergeRequest) toPullRequest(labels []gitlabLabel) *PullRequest {
+	out := &PullRequest{
+		Number:  mr.IID,
+		Title:   mr.Title,
+		Body:    mr.Description,
+		HeadRef: mr.SourceBranch,
+		HeadSHA: mr.LastCommit.ID,
+		BaseRef: mr.TargetBranch,
+		Merged:  mr.State == "merged",
+		Closed:  mr.State == "closed" || mr.State == "merged",
+	}
+	for _, label := range labels {
+		out.Labels = append(out.Labels, label.Title)
+	}
+	return out
+}
+
+func (c *gitlab) ListLabels(ctx context.Context) ([]Label, error) {
+	return nil, ErrUnsupported
+}
+
+func (c *gitlab) CreateLabel(ctx context.Context, label Label) error {
+	return ErrUnsupported
+}
+
+func (c *gitlab) UpdateLabel(ctx context.Context, name string, label Label) error {
+	return ErrUnsupported
+}
+
+func (c *gitlab) DeleteLabel(ctx context.Context, name string) error {
+	return ErrUnsupported
+}
+
+func (c *gitlab) GetBranchProtection(ctx context.Context, branch string) (*BranchProtection, error) {
+	return nil, ErrUnsupported
+}
+
+func (c *gitlab) UpdateBranchProtection(ctx context.Context, branch string, protection BranchProtection) error {
+	return ErrUnsupported
+}
+
+func (c *gitlab) GetFile(ctx context.Context, path, ref string) ([]byte, error) {
+	return c.getRaw(ctx, c.projectPath(fmt.Sprintf("/repository/files/%s/raw?ref=%s", url.PathEscape(strings.Trim(path, "/")), url.QueryEscape(ref))))
+}
+
+func (c *gitlab) ListFiles(ctx context.Context, dir, ref string) ([]string, error) {
+	var files []string
+	for page := 1; ; page++ {
+		var entries []struct {
+			Type string `json:"type"`
+			Path string `json:"path"`
+		}
+		path := fmt.Sprintf("/repository/tree?path=%s&ref=%s&recursive=true&page=%d&per_page=%d", url.QueryEscape(strings.Trim(dir, "/")), url.QueryEscape(ref), page, gitlabPageSize)
+		if err := c.do(ctx, http.MethodGet, c.projectPath(path), nil, &entries); err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if entry.Type == "blob" {
+				files = append(files, entry.Path)
+			}
+		}
+		if len(entries) < gitlabPageSize {
+			return files, nil
+		}
+	}
+}
+
+func (c *gitlab) ParseWebhook(req *http.Request, secret string) (*Webhook, error) {
+	if err := validateToken(secret, req.Header.Get("X-Gitlab-Token")); err != nil {
+		return nil, err
+	}
+	payload, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	switch req.Header.Get("X-Gitlab-Event") {
+	case "Push Hook":
+		var event struct {
+			Ref          string        `json:"ref"`
+			Before       string        `json:"before"`
+			After        string        `json:"after"`
+			UserUsername string        `json:"user_username"`
+			Project      gitlabProject `json:"project"`
+		}
+		if err := json.Unmarshal(payload, &event); err != nil {
+			return nil, err
+		}
+		return &Webhook{Kind: WebhookPush, Repo: event.Project.PathWithNamespace, Sender: event.UserUsername, Ref: event.Ref, Before: event.Before, After: event.After}, nil
+	case "Merge Request Hook":
+		var event struct {
+			User             gitlabUser             `json:"user"`
+			Project          gitlabProject          `json:"project"`
+			ObjectAttributes gitlabHookMergeRequest `json:"object_attributes"`
+			Labels           []gitlabLabel          `json:"labels"`
+		}
+		if err := json.Unmarshal(payload, &event); err != nil {
+			return nil, err
+		}
+		pr := event.ObjectAttributes.toPullRequest(event.Labels)
+		return &Webhook{Kind: WebhookPullRequest, Action: gitlabAction(&event.ObjectAttributes), Repo: event.Project.PathWithNamespace, Sender: event.User.Username, Number: pr.Number, PullRequest: pr}, nil
+	case "Note Hook":
+		var event struct {
+			User             gitlabUser    `json:"user"`
+			Project          gitlabProject `json:"project"`
+			ObjectAttributes struct {
+				ID           int64  `json:"id"`
+				Note         string `json:"note"`
+				NoteableType string `json:"noteable_type"`
+			} `json:"object_attributes"`
+			MergeRequest *gitlabHookMergeRequest `json:"merge_request"`
+			Issue        *struct {
+				IID int `json:"iid"`
+			} `json:"issue"`
+		}
+		if err := json.Unmarshal(payload, &event); err != nil {
+			return nil, err
+		}
+		webhook := &Webhook{
+			Kind:    WebhookComment,
+			Action:  ActionCreated,
+			Repo:    event.Project.PathWithNamespace,
+			Sender:  event.User.Username,
+			Comment: &Comment{ID: event.ObjectAttributes.ID, Body: event.ObjectAttributes.Note, Author: event.User.Username},
+		}
+		switch {
+		case event.ObjectAttributes.NoteableType == "MergeRequest" && event.MergeRequest != nil:
+			webhook.PullRequest = event.MergeRequest.toPullRequest(nil)
+			webhook.Number = event.MergeRequest.IID
+		case event.ObjectAttributes.NoteableType == "Issue" && event.Issue != nil:
+			webhook.Number = event.Issue.IID
+		default:
+			return nil, ErrUnknownEvent
+		}
+		return webhook, nil
+	}
+	return nil, ErrUnknownEvent
+}
+
+// gitlabAction maps gitlab merge request actions to the GitHub ones
+func gitlabAction(mr *gitlabHookMergeRequest) string {
+	switch mr.Action {
+	case "open":
+		return ActionOpened
+	case "close", "merge":
+		return ActionClosed
+	case "reopen":
+		return ActionReopened
+	case "update":
+		if mr.OldRev != "" {
+			return ActionSynchronize
+		}
+		return ActionEdited
+	}
+	return mr.Action
+}
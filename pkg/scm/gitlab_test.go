@@ -0,0 +1,342 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kloops-io/kloops/apis/config/v1alpha1"
+)
+
+// fakeGitLab is a fake gitlab api recording the requests it receives
+type fakeGitLab struct {
+	t *testing.T
+	// routes maps "METHOD path?query" to the handler answering the request, paths are relative to the project
+	routes   map[string]func(body map[string]interface{}) (int, interface{})
+	requests []string
+	bodies   []map[string]interface{}
+}
+
+func newFakeGitLab(t *testing.T) (*fakeGitLab, *gitlab) {
+	f := &fakeGitLab{t: t, routes: map[string]func(map[string]interface{}) (int, interface{}){}}
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+	c := newGitLab(&v1alpha1.GitLabRepo{ServerURL: server.URL, Project: "group/project"}, "token")
+	c.rebasePollInterval = time.Millisecond
+	return f, c
+}
+
+func (f *fakeGitLab) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if got := req.Header.Get("Authorization"); got != "Bearer token" {
+		f.t.Errorf("unexpected authorization %q", got)
+	}
+	const prefix = "/api/v4/projects/group%2Fproject"
+	path := req.URL.EscapedPath()
+	if len(path) >= len(prefix) && path[:len(prefix)] == prefix {
+		path = path[len(prefix):]
+	}
+	route := req.Method + " " + path
+	if req.URL.RawQuery != "" {
+		route += "?" + req.URL.RawQuery
+	}
+	var body map[string]interface{}
+	if data, _ := ioutil.ReadAll(req.Body); len(data) > 0 {
+		if err := json.Unmarshal(data, &body); err != nil {
+			f.t.Errorf("invalid request body %s: %v", data, err)
+		}
+	}
+	f.requests = append(f.requests, route)
+	f.bodies = append(f.bodies, body)
+	handler, ok := f.routes[route]
+	if !ok {
+		f.t.Errorf("unexpected request %s", route)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	code, out := handler(body)
+	// raw contents are written as is, other responses are encoded as json
+	if raw, ok := out.([]byte); ok {
+		w.WriteHeader(code)
+		_, _ = w.Write(raw)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if out != nil {
+		_ = json.NewEncoder(w).Encode(out)
+	}
+}
+
+func respond(code int, out interface{}) func(map[string]interface{}) (int, interface{}) {
+	return func(map[string]interface{}) (int, interface{}) { return code, out }
+}
+
+func TestGitLabHooks(t *testing.T) {
+	f, c := newFakeGitLab(t)
+	ctx := context.Background()
+	f.routes["GET /hooks"] = respond(http.StatusOK, []map[string]interface{}{{"id": 1, "url": "https://hook.example.com"}})
+	f.routes["POST /hooks"] = respond(http.StatusCreated, map[string]interface{}{"id": 2, "url": "https://new.example.com"})
+	f.routes["PUT /hooks/2"] = respond(http.StatusOK, map[string]interface{}{"id": 2, "url": "https://updated.example.com"})
+	f.routes["DELETE /hooks/2"] = respond(http.StatusNoContent, nil)
+
+	hooks, err := c.ListHooks(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []Hook{{ID: 1, URL: "https://hook.example.com", Active: true}}; !reflect.DeepEqual(hooks, want) {
+		t.Errorf("ListHooks() = %+v, want %+v", hooks, want)
+	}
+	created, err := c.CreateHook(ctx, HookSpec{URL: "https://new.example.com", Secret: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.ID != 2 || created.URL != "https://new.example.com" {
+		t.Errorf("CreateHook() = %+v", created)
+	}
+	if body := f.bodies[len(f.bodies)-1]; body["url"] != "https://new.example.com" || body["token"] != "secret" || body["merge_requests_events"] != true {
+		t.Errorf("unexpected create hook request %v", body)
+	}
+	updated, err := c.UpdateHook(ctx, 2, HookSpec{URL: "https://updated.example.com", Secret: "rotated"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.URL != "https://updated.example.com" {
+		t.Errorf("UpdateHook() = %+v", updated)
+	}
+	if body := f.bodies[len(f.bodies)-1]; body["token"] != "rotated" {
+		t.Errorf("unexpected update hook request %v", body)
+	}
+	if err := c.DeleteHook(ctx, 2); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGitLabLabels(t *testing.T) {
+	f, c := newFakeGitLab(t)
+	ctx := context.Background()
+	f.routes["PUT /merge_requests/3"] = respond(http.StatusOK, map[string]interface{}{"iid": 3})
+
+	if err := c.AddLabel(ctx, 3, "lgtm"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.RemoveLabel(ctx, 3, "approved"); err != nil {
+		t.Fatal(err)
+	}
+	want := []map[string]interface{}{{"add_labels": "lgtm"}, {"remove_labels": "approved"}}
+	if !reflect.DeepEqual(f.bodies, want) {
+		t.Errorf("label requests = %v, want %v", f.bodies, want)
+	}
+	if _, err := c.ListLabels(ctx); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("ListLabels() error = %v, want ErrUnsupported", err)
+	}
+}
+
+func TestGitLabFiles(t *testing.T) {
+	f, c := newFakeGitLab(t)
+	ctx := context.Background()
+	f.routes["GET /repository/files/config%2Fplugins.yaml/raw?ref=main"] = respond(http.StatusOK, []byte("plugins: [lgtm]\n"))
+	f.routes["GET /repository/files/config%2Fmissing.yaml/raw?ref=main"] = respond(http.StatusNotFound, map[string]interface{}{"message": "404 File Not Found"})
+	var page []map[string]interface{}
+	for i := 0; i < gitlabPageSize-1; i++ {
+		page = append(page, map[string]interface{}{"type": "blob", "path": fmt.Sprintf("config/%d.yaml", i)})
+	}
+	page = append(page, map[string]interface{}{"type": "tree", "path": "config/sub"})
+	f.routes["GET /repository/tree?path=config&ref=main&recursive=true&page=1&per_page=100"] = respond(http.StatusOK, page)
+	f.routes["GET /repository/tree?path=config&ref=main&recursive=true&page=2&per_page=100"] = respond(http.StatusOK, []map[string]interface{}{
+		{"type": "blob", "path": "config/sub/plugins.yaml"},
+	})
+
+	data, err := c.GetFile(ctx, "config/plugins.yaml", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "plugins: [lgtm]\n" {
+		t.Errorf("GetFile() = %q", data)
+	}
+	if _, err := c.GetFile(ctx, "config/missing.yaml", "main"); !IsNotFound(err) {
+		t.Errorf("GetFile() error = %v, want not found", err)
+	}
+	files, err := c.ListFiles(ctx, "config", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != gitlabPageSize || files[0] != "config/0.yaml" || files[len(files)-1] != "config/sub/plugins.yaml" {
+		t.Errorf("ListFiles() = %v", files)
+	}
+}
+
+func TestGitLabUserPermissions(t *testing.T) {
+	tests := []struct {
+		name   string
+		users  []map[string]interface{}
+		code   int
+		member map[string]interface{}
+		want   Permissions
+	}{{
+		name:   "developer",
+		users:  []map[string]interface{}{{"id": 7}},
+		code:   http.StatusOK,
+		member: map[string]interface{}{"access_level": 30},
+		want:   Permissions{Push: true, Pull: true},
+	}, {
+		name:   "maintainer",
+		users:  []map[string]interface{}{{"id": 7}},
+		code:   http.StatusOK,
+		member: map[string]interface{}{"access_level": 40},
+		want:   Permissions{Admin: true, Push: true, Pull: true},
+	}, {
+		name:  "not a member",
+		users: []map[string]interface{}{{"id": 7}},
+		code:  http.StatusNotFound,
+	}, {
+		name: "unknown user",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, c := newFakeGitLab(t)
+			f.routes["GET /api/v4/users?username=jdoe"] = respond(http.StatusOK, tt.users)
+			f.routes["GET /members/all/7"] = respond(tt.code, tt.member)
+			got, err := c.UserPermissions(context.Background(), "jdoe")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("UserPermissions() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGitLabMerge(t *testing.T) {
+	tests := []struct {
+		name    string
+		options MergeOptions
+		want    map[string]interface{}
+	}{
+		{
+			name:    "squash",
+			options: MergeOptions{Method: v1alpha1.MergeSquash, SHA: "abc", CommitTitle: "title", CommitMessage: "body"},
+			want:    map[string]interface{}{"squash": true, "squash_commit_message": "title\n\nbody", "sha": "abc"},
+		},
+		{
+			name:    "merge",
+			options: MergeOptions{Method: v1alpha1.MergeMerge, SHA: "abc"},
+			want:    map[string]interface{}{"sha": "abc"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, c := newFakeGitLab(t)
+			f.routes["PUT /merge_requests/3/merge"] = respond(http.StatusOK, nil)
+			if err := c.Merge(context.Background(), 3, tt.options); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(f.bodies[0], tt.want) {
+				t.Errorf("merge request = %v, want %v", f.bodies[0], tt.want)
+			}
+		})
+	}
+}
+
+func TestGitLabRebaseMerge(t *testing.T) {
+	f, c := newFakeGitLab(t)
+	polls := 0
+	f.routes["GET /merge_requests/3"] = respond(http.StatusOK, map[string]interface{}{"iid": 3, "sha": "abc"})
+	f.routes["PUT /merge_requests/3/rebase"] = respond(http.StatusAccepted, map[string]interface{}{"rebase_in_progress": true})
+	f.routes["GET /merge_requests/3?include_rebase_in_progress=true"] = func(map[string]interface{}) (int, interface{}) {
+		polls++
+		if polls < 3 {
+			return http.StatusOK, map[string]interface{}{"sha": "abc", "rebase_in_progress": true}
+		}
+		return http.StatusOK, map[string]interface{}{"sha": "def", "rebase_in_progress": false}
+	}
+	f.routes["PUT /merge_requests/3/merge"] = respond(http.StatusOK, nil)
+
+	if err := c.Merge(context.Background(), 3, MergeOptions{Method: v1alpha1.MergeRebase, SHA: "abc"}); err != nil {
+		t.Fatal(err)
+	}
+	last := len(f.requests) - 1
+	if f.requests[last] != "PUT /merge_requests/3/merge" || f.bodies[last]["sha"] != "def" {
+		t.Errorf("expected a merge of the rebased head, got %s %v", f.requests[last], f.bodies[last])
+	}
+}
+
+func TestGitLabRebaseMergeErrors(t *testing.T) {
+	t.Run("head moved", func(t *testing.T) {
+		f, c := newFakeGitLab(t)
+		f.routes["GET /merge_requests/3"] = respond(http.StatusOK, map[string]interface{}{"iid": 3, "sha": "moved"})
+		if err := c.Merge(context.Background(), 3, MergeOptions{Method: v1alpha1.MergeRebase, SHA: "abc"}); err == nil {
+			t.Error("expected an error when the head moved")
+		}
+	})
+	t.Run("rebase conflict", func(t *testing.T) {
+		f, c := newFakeGitLab(t)
+		f.routes["PUT /merge_requests/3/rebase"] = respond(http.StatusAccepted, nil)
+		f.routes["GET /merge_requests/3?include_rebase_in_progress=true"] = respond(http.StatusOK, map[string]interface{}{"rebase_in_progress": false, "merge_error": "Rebase failed"})
+		if err := c.Merge(context.Background(), 3, MergeOptions{Method: v1alpha1.MergeRebase}); err == nil {
+			t.Error("expected an error when the rebase failed")
+		}
+		for _, request := range f.requests {
+			if request == "PUT /merge_requests/3/merge" {
+				t.Error("merge request merged after a failed rebase")
+			}
+		}
+	})
+}
+
+func TestGitLabParseWebhookToken(t *testing.T) {
+	payload := []byte(`{"ref":"refs/heads/master","before":"abc","after":"def","user_username":"alice","project":{"path_with_namespace":"group/project"}}`)
+	tests := []struct {
+		name    string
+		secret  string
+		token   string
+		wantErr error
+	}{
+		{name: "valid token", secret: "secret", token: "secret"},
+		{name: "invalid token", secret: "secret", token: "other", wantErr: ErrInvalidSignature},
+		{name: "missing token", secret: "secret", wantErr: ErrInvalidSignature},
+		{name: "no secret configured", wantErr: ErrNoSecret},
+		{name: "no secret configured with a token", token: "secret", wantErr: ErrNoSecret},
+	}
+	c := newGitLab(&v1alpha1.GitLabRepo{Project: "group/project"}, "token")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/hook", bytes.NewReader(payload))
+			req.Header.Set("X-Gitlab-Event", "Push Hook")
+			if tt.token != "" {
+				req.Header.Set("X-Gitlab-Token", tt.token)
+			}
+			hook, err := c.ParseWebhook(req, tt.secret)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseWebhook() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (hook.Kind != WebhookPush || hook.Repo != "group/project" || hook.After != "def") {
+				t.Errorf("ParseWebhook() = %+v", hook)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// defaultHTTPClient is used when no http client is configured, git server calls must not hang forever
+var defaultHTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+// Error is returned when the git server answers with an unexpected status code
+type Error struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("git server returned status %d: %s", e.StatusCode, e.Message)
+}
+
+// IsNotFound tells whether the error is a not found error returned by the git server
+func IsNotFound(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && e.StatusCode == http.StatusNotFound
+}
+
+type httpClient struct {
+	baseURL       string
+	authorization string
+	// authorize returns the authorization header value, it takes precedence over authorization when set
+	authorize func(context.Context) (string, error)
+	client    *http.Client
+}
+
+func (c *httpClient) do(ctx context.Context, method, path string, in, out interface{}) error {
+	_, err := c.doWithHeaders(ctx, method, path, in, out)
+	return err
+}
+
+// doWithHeaders sends a request and returns the response headers
+func (c *httpClient) doWithHeaders(ctx context.Context, method, path string, in, out interface{}) (http.Header, error) {
+	data, header, err := c.send(ctx, method, path, "application/json", in)
+	if err != nil {
+		return nil, err
+	}
+	if out != nil && len(data) > 0 {
+		if err := json.Unmarshal(data, out); err != nil {
+			return nil, err
+		}
+	}
+	return header, nil
+}
+
+// getRaw sends a GET request and returns the response body as is, for endpoints not answering json
+func (c *httpClient) getRaw(ctx context.Context, path string) ([]byte, error) {
+	data, _, err := c.send(ctx, http.MethodGet, path, "*/*", nil)
+	return data, err
+}
+
+// send sends a request and returns the response body and headers
+func (c *httpClient) send(ctx context.Context, method, path, accept string, in interface{}) ([]byte, http.Header, error) {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return nil, nil, err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, strings.TrimSuffix(c.baseURL, "/")+path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", accept)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	authorization := c.authorization
+	if c.authorize != nil {
+		if authorization, err = c.authorize(ctx); err != nil {
+			return nil, nil, err
+		}
+	}
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	client := c.client
+	if client == nil {
+		client = defaultHTTPClient
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, nil, &Error{StatusCode: res.StatusCode, Message: string(data)}
+	}
+	return data, res.Header, nil
+}
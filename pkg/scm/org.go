@@ -0,0 +1,46 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scm
+
+import (
+	"context"
+
+	"github.com/kloops-io/kloops/apis/config/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// orgRepoLister is implemented by the clients able to list the repositories of an organization
+type orgRepoLister interface {
+	listOrgRepos(ctx context.Context, owner string) ([]string, error)
+}
+
+// NewOrgClientFromSpec creates a client authenticated with the credentials of the organization defined in the spec,
+// resolving them from the secrets of the given namespace. The client is not bound to a repository.
+func NewOrgClientFromSpec(ctx context.Context, c client.Reader, namespace string, spec *v1alpha1.OrgConfigSpec) (Client, error) {
+	repoSpec := spec.ForRepo("")
+	return NewClientFromSpec(ctx, c, namespace, &repoSpec)
+}
+
+// ListOrgRepos returns the names of the repositories of an organization (organization or user, group, project),
+// archived repositories are skipped. The client must be created with NewOrgClientFromSpec.
+func ListOrgRepos(ctx context.Context, c Client, owner string) ([]string, error) {
+	lister, ok := c.(orgRepoLister)
+	if !ok {
+		return nil, ErrUnsupported
+	}
+	return lister.listOrgRepos(ctx, owner)
+}
@@ -0,0 +1,270 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package scm contains a minimal client for the git servers supported by RepoConfig.
+package scm
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/kloops-io/kloops/apis/config/v1alpha1"
+)
+
+// Client is the interface used to interact with a repository on its git server
+type Client interface {
+	// CurrentUser returns the login of the authenticated user
+	CurrentUser(ctx context.Context) (string, error)
+	// Permissions returns the permissions of the authenticated user on the repository
+	Permissions(ctx context.Context) (Permissions, error)
+	// UserPermissions returns the permissions of the user with the given login on the repository,
+	// users without access to the repository have no permission
+	UserPermissions(ctx context.Context, login string) (Permissions, error)
+	// ListHooks returns the repository webhooks
+	ListHooks(ctx context.Context) ([]Hook, error)
+	// CreateHook creates a repository webhook
+	CreateHook(ctx context.Context, hook HookSpec) (Hook, error)
+	// UpdateHook updates the repository webhook with the given id
+	UpdateHook(ctx context.Context, id int64, hook HookSpec) (Hook, error)
+	// DeleteHook deletes the repository webhook with the given id
+	DeleteHook(ctx context.Context, id int64) error
+	// CreateComment posts a comment on a pull request
+	CreateComment(ctx context.Context, number int, body string) error
+	// AddLabel adds a label to a pull request
+	AddLabel(ctx context.Context, number int, label string) error
+	// RemoveLabel removes a label from a pull request
+	RemoveLabel(ctx context.Context, number int, label string) error
+	// CreateStatus sets a commit status
+	CreateStatus(ctx context.Context, sha string, status Status) error
+	// ListStatuses returns the latest commit statuses of a ref, one per context
+	ListStatuses(ctx context.Context, ref string) ([]Status, error)
+	// GetBranchSHA returns the sha of the head commit of a branch
+	GetBranchSHA(ctx context.Context, branch string) (string, error)
+	// GetPullRequest returns a pull request
+	GetPullRequest(ctx context.Context, number int) (*PullRequest, error)
+	// Merge merges a pull request
+	Merge(ctx context.Context, number int, options MergeOptions) error
+	// UpdateBranch updates a pull request branch with its base branch using the given method
+	UpdateBranch(ctx context.Context, number int, method v1alpha1.UpdateBranchMethod) error
+	// ListLabels returns the repository labels
+	ListLabels(ctx context.Context) ([]Label, error)
+	// CreateLabel creates a repository label
+	CreateLabel(ctx context.Context, label Label) error
+	// UpdateLabel updates (and renames) the repository label with the given name
+	UpdateLabel(ctx context.Context, name string, label Label) error
+	// DeleteLabel deletes the repository label with the given name
+	DeleteLabel(ctx context.Context, name string) error
+	// GetBranchProtection returns the protection rules of a branch, nil if the branch is not protected
+	GetBranchProtection(ctx context.Context, branch string) (*BranchProtection, error)
+	// UpdateBranchProtection creates or replaces the protection rules of a branch
+	UpdateBranchProtection(ctx context.Context, branch string, protection BranchProtection) error
+	// GetFile returns the content of a file at the given ref
+	GetFile(ctx context.Context, path, ref string) ([]byte, error)
+	// ListFiles returns the paths of the files in a directory and its sub directories at the given ref
+	ListFiles(ctx context.Context, dir, ref string) ([]string, error)
+	// ParseWebhook validates and parses a webhook request sent by the git server
+	ParseWebhook(req *http.Request, secret string) (*Webhook, error)
+}
+
+// StatusState is the state of a commit status
+type StatusState string
+
+// Possible commit status states
+const (
+	StatusPending StatusState = "pending"
+	StatusSuccess StatusState = "success"
+	StatusFailure StatusState = "failure"
+	StatusError   StatusState = "error"
+)
+
+// Status defines a commit status
+type Status struct {
+	State       StatusState
+	Context     string
+	Description string
+	TargetURL   string
+}
+
+// PullRequest defines a pull request (or merge request)
+type PullRequest struct {
+	Number    int
+	Title     string
+	Body      string
+	Author    string
+	HeadRef   string
+	HeadSHA   string
+	BaseRef   string
+	Labels    []string
+	Mergeable *bool
+	Merged    bool
+	Closed    bool
+	Created   time.Time
+}
+
+// MergeOptions defines how a pull request is merged
+type MergeOptions struct {
+	// Method is the merge method
+	Method v1alpha1.PullRequestMergeType
+	// SHA is the expected pull request head sha, the merge fails if the head moved
+	SHA string
+	// CommitTitle is the merge commit title, the git server default is used when empty
+	CommitTitle string
+	// CommitMessage is the merge commit message, the git server default is used when empty
+	CommitMessage string
+}
+
+// Label defines a repository label
+type Label struct {
+	Name string
+	// Color is the label hex color code, without the leading #
+	Color       string
+	Description string
+}
+
+// BranchProtection defines the protection rules of a branch
+type BranchProtection struct {
+	// RequiredStatusContexts are the status contexts that must succeed before merging
+	RequiredStatusContexts []string
+	// RequiredApprovals is the number of approving reviews required before merging
+	RequiredApprovals int
+	// DismissStaleReviews dismisses approvals when new commits are pushed
+	DismissStaleReviews bool
+	// PushTeams are the teams allowed to push, anyone with write access can push when empty
+	PushTeams []string
+	// EnforceAdmins applies the rules to repository administrators
+	EnforceAdmins bool
+}
+
+// HookSpec defines the desired state of a repository webhook
+type HookSpec struct {
+	// URL is the url the webhook payloads are sent to
+	URL string
+	// Secret is the secret used to sign the webhook payloads
+	Secret string
+}
+
+// Permissions defines the permissions of a user on a repository
+type Permissions struct {
+	Admin bool `json:"admin"`
+	Push  bool `json:"push"`
+	Pull  bool `json:"pull"`
+}
+
+// roleToPermissions returns the permissions of a GitHub or Gitea repository role
+func roleToPermissions(role string) Permissions {
+	switch role {
+	case "owner", "admin":
+		return Permissions{Admin: true, Push: true, Pull: true}
+	case "maintain", "write":
+		return Permissions{Push: true, Pull: true}
+	case "triage", "read":
+		return Permissions{Pull: true}
+	}
+	return Permissions{}
+}
+
+// Hook defines a repository webhook
+type Hook struct {
+	ID     int64
+	URL    string
+	Active bool
+	Events []string
+	// LastDelivery is the result of the last payload delivery, nil when the git server does not expose it or nothing was delivered yet
+	LastDelivery *HookDelivery
+}
+
+// HookDelivery defines the result of a webhook payload delivery
+type HookDelivery struct {
+	// Code is the http status code returned by the hook url, 0 when the request failed
+	Code    int
+	Message string
+}
+
+// Failed tells whether the delivery failed
+func (d *HookDelivery) Failed() bool {
+	return d.Code < 200 || d.Code >= 300
+}
+
+// FindHook returns the hook pointing at the given url, nil if not found
+func FindHook(hooks []Hook, url string) *Hook {
+	for i := range hooks {
+		if hooks[i].URL == url {
+			return &hooks[i]
+		}
+	}
+	return nil
+}
+
+// ErrNoProvider is returned when a RepoConfig does not define a git server
+var ErrNoProvider = errors.New("no git server defined in repo config")
+
+// ErrUnsupported is returned when an operation is not supported by the git server
+var ErrUnsupported = errors.New("operation not supported by the git server")
+
+// NewClient creates a client for the repository defined in the spec, authenticated with the given token
+func NewClient(spec *v1alpha1.RepoConfigSpec, token string) (Client, error) {
+	switch {
+	case spec.GitHub != nil:
+		return newGitHub(spec.GitHub, token), nil
+	case spec.Gitea != nil:
+		return newGitea(spec.Gitea, token), nil
+	case spec.GitLab != nil:
+		return newGitLab(spec.GitLab, token), nil
+	case spec.BitbucketServer != nil:
+		return newBitbucketServer(spec.BitbucketServer, token), nil
+	}
+	return nil, ErrNoProvider
+}
+
+// HmacTokenSecret returns the secret holding the token used to validate webhooks of the repository defined in the spec
+func HmacTokenSecret(spec *v1alpha1.RepoConfigSpec) (v1alpha1.Secret, error) {
+	switch {
+	case spec.GitHub != nil:
+		return spec.GitHub.HmacToken, nil
+	case spec.Gitea != nil:
+		return spec.Gitea.HmacToken, nil
+	case spec.GitLab != nil:
+		return spec.GitLab.WebhookToken, nil
+	case spec.BitbucketServer != nil:
+		return spec.BitbucketServer.HmacToken, nil
+	}
+	return v1alpha1.Secret{}, ErrNoProvider
+}
+
+// AppPrivateKeySecret returns the secret holding the GitHub App private key used to mint the tokens of the repository defined in the spec
+func AppPrivateKeySecret(spec *v1alpha1.RepoConfigSpec) (v1alpha1.Secret, error) {
+	if spec.GitHub == nil || spec.GitHub.App == nil {
+		return v1alpha1.Secret{}, ErrNoProvider
+	}
+	return spec.GitHub.App.PrivateKey, nil
+}
+
+// TokenSecret returns the secret holding the token used to interact with the repository defined in the spec
+func TokenSecret(spec *v1alpha1.RepoConfigSpec) (v1alpha1.Secret, error) {
+	switch {
+	case spec.GitHub != nil:
+		return spec.GitHub.Token, nil
+	case spec.Gitea != nil:
+		return spec.Gitea.Token, nil
+	case spec.GitLab != nil:
+		return spec.GitLab.Token, nil
+	case spec.BitbucketServer != nil:
+		return spec.BitbucketServer.Token, nil
+	}
+	return v1alpha1.Secret{}, ErrNoProvider
+}
@@ -0,0 +1,136 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scm
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// WebhookKind is the kind of a webhook event
+type WebhookKind string
+
+// Supported webhook kinds
+const (
+	WebhookPush        WebhookKind = "push"
+	WebhookPullRequest WebhookKind = "pull_request"
+	WebhookComment     WebhookKind = "comment"
+)
+
+// Pull request webhook actions, git server specific actions are mapped to these values
+const (
+	ActionOpened      = "opened"
+	ActionClosed      = "closed"
+	ActionReopened    = "reopened"
+	ActionEdited      = "edited"
+	ActionSynchronize = "synchronize"
+	ActionLabeled     = "labeled"
+	ActionUnlabeled   = "unlabeled"
+	ActionCreated     = "created"
+)
+
+// Comment defines a pull request comment
+type Comment struct {
+	ID     int64
+	Body   string
+	Author string
+}
+
+// Webhook is a webhook event sent by a git server
+type Webhook struct {
+	// Kind is the kind of event
+	Kind WebhookKind
+	// Action is the action that triggered a pull request or comment event
+	Action string
+	// Repo is the repository full name
+	Repo string
+	// Sender is the login of the user that triggered the event
+	Sender string
+	// Ref is the pushed ref for push events
+	Ref string
+	// Before is the ref sha before a push
+	Before string
+	// After is the ref sha after a push
+	After string
+	// Number is the pull request or issue number for pull request and comment events
+	Number int
+	// PullRequest is the pull request for pull request events and comments on pull requests
+	PullRequest *PullRequest
+	// Comment is the comment for comment events
+	Comment *Comment
+}
+
+// ErrInvalidSignature is returned when a webhook signature does not match the secret
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// ErrNoSecret is returned when validating a webhook without a secret, unsigned webhooks are never accepted
+var ErrNoSecret = errors.New("no webhook secret configured")
+
+// ErrUnknownEvent is returned when a webhook event is not supported
+var ErrUnknownEvent = errors.New("unsupported webhook event")
+
+func readBody(req *http.Request) ([]byte, error) {
+	defer req.Body.Close()
+	return ioutil.ReadAll(req.Body)
+}
+
+// validateHmac checks that the hex encoded signature is the hmac of the payload
+func validateHmac(newHash func() hash.Hash, secret string, payload []byte, signature string) error {
+	if secret == "" {
+		return ErrNoSecret
+	}
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	mac := hmac.New(newHash, []byte(secret))
+	mac.Write(payload)
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// validateGitHubSignature validates the X-Hub-Signature-256 header, falling back to X-Hub-Signature
+func validateGitHubSignature(req *http.Request, secret string, payload []byte) error {
+	if signature := req.Header.Get("X-Hub-Signature-256"); signature != "" {
+		return validateHmac(sha256.New, secret, payload, strings.TrimPrefix(signature, "sha256="))
+	}
+	if signature := req.Header.Get("X-Hub-Signature"); signature != "" {
+		return validateHmac(sha1.New, secret, payload, strings.TrimPrefix(signature, "sha1="))
+	}
+	return ErrInvalidSignature
+}
+
+// validateToken checks that the token sent with the webhook matches the secret
+func validateToken(secret, token string) error {
+	if secret == "" {
+		return ErrNoSecret
+	}
+	if subtle.ConstantTimeCompare([]byte(secret), []byte(token)) != 1 {
+		return ErrInvalidSignature
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/kloops-io/kloops/apis/config/v1alpha1"
+)
+
+// Provider reads secret values from an external secret store.
+// Providers must scope secrets per namespace, a config in a namespace must not be able to read
+// the secrets of another namespace (or the secrets of the controller) by choosing a path.
+type Provider interface {
+	// Get returns the value of the given key of the secret stored at path, for a config of the given namespace
+	Get(ctx context.Context, namespace, path, key string) (string, error)
+}
+
+var (
+	providersMu sync.RWMutex
+	providers   = map[string]Provider{}
+)
+
+// RegisterProvider makes an external secret store available to externalRef secrets under the given name
+func RegisterProvider(name string, provider Provider) {
+	providersMu.Lock()
+	defer providersMu.Unlock()
+	providers[name] = provider
+}
+
+func getExternal(ctx context.Context, namespace string, ref v1alpha1.ExternalRef) (string, error) {
+	providersMu.RLock()
+	provider, ok := providers[ref.Provider]
+	providersMu.RUnlock()
+	if !ok {
+		return "", fmt.Errorf("secret provider %s is not registered", ref.Provider)
+	}
+	value, err := provider.Get(ctx, namespace, ref.Path, ref.Key)
+	if err != nil {
+		return "", fmt.Errorf("failed to get secret %s from %s: %w", ref.Path, ref.Provider, err)
+	}
+	return value, nil
+}
@@ -0,0 +1,162 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secrets
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kloops-io/kloops/apis/config/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+)
+
+// DefaultPollInterval is the default interval at which file and external secrets are read again
+const DefaultPollInterval = 30 * time.Second
+
+// Resolver resolves config secrets from an informer backed cache of kubernetes secrets
+// and notifies subscribers when the value of a secret they subscribed to changes.
+type Resolver struct {
+	// PollInterval is the interval at which file and external secrets are read again to detect changes
+	PollInterval  time.Duration
+	cache         cache.Cache
+	mu            sync.Mutex
+	subscriptions map[types.NamespacedName]map[*subscription]struct{}
+}
+
+type subscription struct {
+	namespace string
+	secret    v1alpha1.Secret
+	onChange  func(value string, err error)
+	// resolved tells whether value and err hold the last resolution result
+	resolved bool
+	value    string
+	err      string
+}
+
+// NewResolver creates a resolver reading secrets from the given cache, the cache must be started for secrets to resolve
+func NewResolver(ctx context.Context, c cache.Cache) (*Resolver, error) {
+	r := &Resolver{
+		PollInterval:  DefaultPollInterval,
+		cache:         c,
+		subscriptions: map[types.NamespacedName]map[*subscription]struct{}{},
+	}
+	informer, err := c.GetInformer(ctx, &corev1.Secret{})
+	if err != nil {
+		return nil, err
+	}
+	informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    r.changed,
+		UpdateFunc: func(_, obj interface{}) { r.changed(obj) },
+		DeleteFunc: r.changed,
+	})
+	return r, nil
+}
+
+// Resolve returns the value of a secret
+func (r *Resolver) Resolve(ctx context.Context, namespace string, secret v1alpha1.Secret) (string, error) {
+	return Get(ctx, r.cache, namespace, secret)
+}
+
+// Subscribe returns the value of a secret and calls onChange every time the referenced kubernetes secret,
+// file or external secret changes the value (or starts or stops failing to resolve), until the returned cancel function is called.
+// Kubernetes secrets are watched, files and external secrets are polled.
+// Inline and environment variable values never change and onChange is never called for them.
+// The subscription is active even when the secret does not resolve yet, the cancel function is always returned.
+func (r *Resolver) Subscribe(ctx context.Context, namespace string, secret v1alpha1.Secret, onChange func(value string, err error)) (string, func(), error) {
+	sub := &subscription{namespace: namespace, secret: secret, onChange: onChange}
+	var cancel func()
+	switch {
+	case secret.ValueFrom == nil || secret.ValueFrom.EnvRef != nil:
+		cancel = func() {}
+	case secret.ValueFrom.SecretKeyRef == nil:
+		stop := make(chan struct{})
+		var once sync.Once
+		cancel = func() { once.Do(func() { close(stop) }) }
+		// polling starts once the value is resolved
+		defer func() { go wait.Until(func() { r.refresh(sub) }, r.PollInterval, stop) }()
+	default:
+		// the subscription is registered before resolving the value, so that no change is missed in between
+		key := types.NamespacedName{Namespace: namespace, Name: secret.ValueFrom.SecretKeyRef.Name}
+		r.mu.Lock()
+		if r.subscriptions[key] == nil {
+			r.subscriptions[key] = map[*subscription]struct{}{}
+		}
+		r.subscriptions[key][sub] = struct{}{}
+		r.mu.Unlock()
+		cancel = func() {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			delete(r.subscriptions[key], sub)
+			if len(r.subscriptions[key]) == 0 {
+				delete(r.subscriptions, key)
+			}
+		}
+	}
+	value, err := r.Resolve(ctx, namespace, secret)
+	r.mu.Lock()
+	if !sub.resolved {
+		sub.resolved = true
+		sub.value, sub.err = value, errorString(err)
+	}
+	r.mu.Unlock()
+	return value, cancel, err
+}
+
+func (r *Resolver) changed(obj interface{}) {
+	if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		return
+	}
+	key := types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name}
+	r.mu.Lock()
+	var subs []*subscription
+	for sub := range r.subscriptions[key] {
+		subs = append(subs, sub)
+	}
+	r.mu.Unlock()
+	for _, sub := range subs {
+		r.refresh(sub)
+	}
+}
+
+// refresh resolves the secret again and notifies the subscriber when the value or the error changed
+func (r *Resolver) refresh(sub *subscription) {
+	value, err := r.Resolve(context.Background(), sub.namespace, sub.secret)
+	r.mu.Lock()
+	changed := !sub.resolved || value != sub.value || errorString(err) != sub.err
+	sub.resolved = true
+	sub.value, sub.err = value, errorString(err)
+	r.mu.Unlock()
+	if changed {
+		sub.onChange(value, err)
+	}
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,184 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package secrets resolves the values of config Secret fields.
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/kloops-io/kloops/apis/config/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Secret definition errors
+var (
+	ErrNoValue        = errors.New("secret must set one of value or valueFrom")
+	ErrAmbiguousValue = errors.New("secret value and valueFrom are mutually exclusive")
+	ErrNoRef          = errors.New("secret valueFrom must set one of secretKeyRef, fileRef, envRef or externalRef")
+	ErrAmbiguousRef   = errors.New("secret valueFrom secretKeyRef, fileRef, envRef and externalRef are mutually exclusive")
+	ErrNotAllowed     = errors.New("secret reference is not allowed by the operator")
+)
+
+// fileRef and envRef secrets read the controller files and environment, that also hold the controller credentials.
+// They are denied unless the operator allows the directories and variables repo configs may read.
+var (
+	allowedMu    sync.RWMutex
+	allowedDirs  []string
+	allowedNames = map[string]bool{}
+)
+
+// AllowFileRefs allows fileRef secrets to read the files in the given directories (and their sub directories).
+// fileRef secrets are denied by default.
+func AllowFileRefs(dirs ...string) {
+	allowedMu.Lock()
+	defer allowedMu.Unlock()
+	for _, dir := range dirs {
+		allowedDirs = append(allowedDirs, filepath.Clean(dir))
+	}
+}
+
+// AllowEnvRefs allows envRef secrets to read the given environment variables.
+// envRef secrets are denied by default.
+func AllowEnvRefs(names ...string) {
+	allowedMu.Lock()
+	defer allowedMu.Unlock()
+	for _, name := range names {
+		allowedNames[name] = true
+	}
+}
+
+// Validate checks that exactly one of value or valueFrom is set, and that valueFrom sets exactly one reference
+func Validate(secret v1alpha1.Secret) error {
+	switch {
+	case secret.Value != "" && secret.ValueFrom != nil:
+		return ErrAmbiguousValue
+	case secret.Value == "" && secret.ValueFrom == nil:
+		return ErrNoValue
+	case secret.ValueFrom == nil:
+		return nil
+	}
+	refs := 0
+	for _, set := range []bool{
+		secret.ValueFrom.SecretKeyRef != nil,
+		secret.ValueFrom.FileRef != nil,
+		secret.ValueFrom.EnvRef != nil,
+		secret.ValueFrom.ExternalRef != nil,
+	} {
+		if set {
+			refs++
+		}
+	}
+	switch {
+	case refs == 0:
+		return ErrNoRef
+	case refs > 1:
+		return ErrAmbiguousRef
+	}
+	return nil
+}
+
+// Get returns the value of a secret, reading the referenced kubernetes secret in the given namespace,
+// file, environment variable or external secret store if needed
+func Get(ctx context.Context, c client.Reader, namespace string, secret v1alpha1.Secret) (string, error) {
+	if err := Validate(secret); err != nil {
+		return "", err
+	}
+	from := secret.ValueFrom
+	switch {
+	case from == nil:
+		return secret.Value, nil
+	case from.SecretKeyRef != nil:
+		return getSecretKey(ctx, c, namespace, *from.SecretKeyRef)
+	case from.FileRef != nil:
+		return getFile(*from.FileRef)
+	case from.EnvRef != nil:
+		return getEnv(*from.EnvRef)
+	default:
+		return getExternal(ctx, namespace, *from.ExternalRef)
+	}
+}
+
+func getSecretKey(ctx context.Context, c client.Reader, namespace string, ref corev1.SecretKeySelector) (string, error) {
+	optional := ref.Optional != nil && *ref.Optional
+	var s corev1.Secret
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, &s); err != nil {
+		if optional && apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to get secret %s/%s: %w", namespace, ref.Name, err)
+	}
+	value, ok := s.Data[ref.Key]
+	if !ok && !optional {
+		return "", fmt.Errorf("key %s not found in secret %s/%s", ref.Key, namespace, ref.Name)
+	}
+	return string(value), nil
+}
+
+func getFile(ref v1alpha1.FileRef) (string, error) {
+	if !filepath.IsAbs(ref.Path) {
+		return "", fmt.Errorf("secret file path %s is not absolute", ref.Path)
+	}
+	// symbolic links are resolved so that they cannot point outside of the allowed directories
+	path, err := filepath.EvalSymlinks(ref.Path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret file: %w", err)
+	}
+	if !fileAllowed(path) {
+		return "", fmt.Errorf("secret file %s: %w", ref.Path, ErrNotAllowed)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret file: %w", err)
+	}
+	// files edited by hand usually end with a new line that is not part of the value
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+func fileAllowed(path string) bool {
+	allowedMu.RLock()
+	defer allowedMu.RUnlock()
+	for _, dir := range allowedDirs {
+		if rel, err := filepath.Rel(dir, path); err == nil && rel != ".." && !strings.HasPrefix(rel, "../") {
+			return true
+		}
+	}
+	return false
+}
+
+func getEnv(ref v1alpha1.EnvRef) (string, error) {
+	allowedMu.RLock()
+	allowed := allowedNames[ref.Name]
+	allowedMu.RUnlock()
+	if !allowed {
+		return "", fmt.Errorf("environment variable %s: %w", ref.Name, ErrNotAllowed)
+	}
+	value, ok := os.LookupEnv(ref.Name)
+	if !ok {
+		return "", fmt.Errorf("environment variable %s is not set", ref.Name)
+	}
+	return value, nil
+}
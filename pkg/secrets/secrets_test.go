@@ -0,0 +1,79 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secrets
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kloops-io/kloops/apis/config/v1alpha1"
+)
+
+func TestGetFileAllowed(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secrets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	allowed := filepath.Join(dir, "allowed")
+	denied := filepath.Join(dir, "denied")
+	for _, d := range []string{allowed, denied} {
+		if err := os.Mkdir(d, 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(d, "token"), []byte("value\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Symlink(filepath.Join(denied, "token"), filepath.Join(allowed, "link")); err != nil {
+		t.Fatal(err)
+	}
+	defer func(dirs []string) { allowedDirs = dirs }(allowedDirs)
+	AllowFileRefs(allowed + "/")
+
+	if value, err := getFile(v1alpha1.FileRef{Path: filepath.Join(allowed, "token")}); err != nil || value != "value" {
+		t.Errorf("getFile() = %q, %v, want value", value, err)
+	}
+	for _, path := range []string{
+		filepath.Join(denied, "token"),
+		filepath.Join(allowed, "..", "denied", "token"),
+		filepath.Join(allowed, "link"),
+	} {
+		if _, err := getFile(v1alpha1.FileRef{Path: path}); !errors.Is(err, ErrNotAllowed) {
+			t.Errorf("getFile(%s) error = %v, want ErrNotAllowed", path, err)
+		}
+	}
+}
+
+func TestGetEnvAllowed(t *testing.T) {
+	os.Setenv("KLOOPS_TEST_ALLOWED", "value")
+	os.Setenv("KLOOPS_TEST_DENIED", "value")
+	defer os.Unsetenv("KLOOPS_TEST_ALLOWED")
+	defer os.Unsetenv("KLOOPS_TEST_DENIED")
+	defer delete(allowedNames, "KLOOPS_TEST_ALLOWED")
+	AllowEnvRefs("KLOOPS_TEST_ALLOWED")
+
+	if value, err := getEnv(v1alpha1.EnvRef{Name: "KLOOPS_TEST_ALLOWED"}); err != nil || value != "value" {
+		t.Errorf("getEnv() = %q, %v, want value", value, err)
+	}
+	if _, err := getEnv(v1alpha1.EnvRef{Name: "KLOOPS_TEST_DENIED"}); !errors.Is(err, ErrNotAllowed) {
+		t.Errorf("getEnv() error = %v, want ErrNotAllowed", err)
+	}
+}
@@ -0,0 +1,151 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// VaultProviderName is the provider name of HashiCorp Vault in externalRef secrets
+const VaultProviderName = "vault"
+
+// Default Vault KV secrets engine settings
+const (
+	DefaultVaultMount     = "secret"
+	DefaultVaultKVVersion = 2
+)
+
+// Vault reads secrets from a HashiCorp Vault KV secrets engine.
+// Secrets are scoped per namespace, the path of an externalRef is relative to <PathPrefix>/<namespace>.
+type Vault struct {
+	// Address is the Vault server address (https://vault.example.com:8200)
+	Address string
+	// Token is the Vault token used to authenticate
+	Token string
+	// Mount is the path the KV secrets engine is mounted at, defaults to secret
+	Mount string
+	// KVVersion is the version of the KV secrets engine (1 or 2), defaults to 2
+	KVVersion int
+	// PathPrefix is the path, relative to the mount, under which the secrets of each namespace are stored
+	PathPrefix string
+	// Client is the http client used to talk to Vault, defaults to http.DefaultClient
+	Client *http.Client
+}
+
+// NewVaultFromEnv creates a Vault provider configured with the standard VAULT_ADDR and VAULT_TOKEN
+// environment variables, the KV secrets engine is configured with VAULT_KV_MOUNT, VAULT_KV_VERSION and VAULT_KV_PATH_PREFIX
+func NewVaultFromEnv() *Vault {
+	v := &Vault{
+		Address:    os.Getenv("VAULT_ADDR"),
+		Token:      os.Getenv("VAULT_TOKEN"),
+		Mount:      os.Getenv("VAULT_KV_MOUNT"),
+		PathPrefix: os.Getenv("VAULT_KV_PATH_PREFIX"),
+	}
+	if os.Getenv("VAULT_KV_VERSION") == "1" {
+		v.KVVersion = 1
+	}
+	return v
+}
+
+// Get implements Provider
+func (v *Vault) Get(ctx context.Context, namespace, path, key string) (string, error) {
+	mount := v.Mount
+	if mount == "" {
+		mount = DefaultVaultMount
+	}
+	mount = strings.Trim(mount, "/")
+	if namespace == "" {
+		return "", fmt.Errorf("secret %s: vault secrets must be read for a namespace", path)
+	}
+	var segments []string
+	for _, segment := range strings.Split(strings.Trim(v.PathPrefix, "/")+"/"+namespace+"/"+strings.Trim(path, "/"), "/") {
+		switch segment {
+		case "":
+		case ".", "..":
+			return "", fmt.Errorf("secret %s: path must not contain . or .. segments", path)
+		default:
+			segments = append(segments, url.PathEscape(segment))
+		}
+	}
+	endpoint := fmt.Sprintf("%s/v1/%s/%s", strings.TrimRight(v.Address, "/"), mount, strings.Join(segments, "/"))
+	if v.kvVersion() == 2 {
+		endpoint = fmt.Sprintf("%s/v1/%s/data/%s", strings.TrimRight(v.Address, "/"), mount, strings.Join(segments, "/"))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", v.Token)
+	client := v.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return "", fmt.Errorf("secret %s not found", path)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("vault returned status %d", resp.StatusCode)
+	}
+	var body struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("failed to decode vault response: %w", err)
+	}
+	data := body.Data
+	if v.kvVersion() == 2 {
+		// kv v2 wraps the secret data along with its metadata
+		var versioned struct {
+			Data json.RawMessage `json:"data"`
+		}
+		if err := json.Unmarshal(data, &versioned); err != nil {
+			return "", fmt.Errorf("failed to decode vault response: %w", err)
+		}
+		data = versioned.Data
+	}
+	var values map[string]interface{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return "", fmt.Errorf("failed to decode vault response: %w", err)
+	}
+	value, ok := values[key]
+	if !ok {
+		return "", fmt.Errorf("key %s not found in secret %s", key, path)
+	}
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("key %s of secret %s is not a string", key, path)
+	}
+	return s, nil
+}
+
+func (v *Vault) kvVersion() int {
+	if v.KVVersion == 0 {
+		return DefaultVaultKVVersion
+	}
+	return v.KVVersion
+}
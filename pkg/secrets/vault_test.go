@@ -0,0 +1,182 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kloops-io/kloops/apis/config/v1alpha1"
+)
+
+// fakeVault serves the given secrets, keyed by request path, to requests with the vault token
+func fakeVault(t *testing.T, secrets map[string]interface{}) (*httptest.Server, *[]string) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.URL.EscapedPath())
+		if req.Header.Get("X-Vault-Token") != "vault-token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		secret, ok := secrets[req.URL.EscapedPath()]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(secret)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestVaultGet(t *testing.T) {
+	server, requests := fakeVault(t, map[string]interface{}{
+		"/v1/secret/data/kloops/team-a/github": map[string]interface{}{
+			"data": map[string]interface{}{"data": map[string]interface{}{"token": "team-a-token", "count": 1}},
+		},
+		"/v1/kv/kloops/team-a/github": map[string]interface{}{
+			"data": map[string]interface{}{"token": "kv1-token"},
+		},
+	})
+	tests := []struct {
+		name      string
+		vault     Vault
+		namespace string
+		path      string
+		key       string
+		want      string
+		wantErr   string
+		wantPath  string
+	}{
+		{
+			name:      "kv v2",
+			vault:     Vault{PathPrefix: "kloops"},
+			namespace: "team-a",
+			path:      "github",
+			key:       "token",
+			want:      "team-a-token",
+			wantPath:  "/v1/secret/data/kloops/team-a/github",
+		},
+		{
+			name:      "kv v1",
+			vault:     Vault{Mount: "/kv/", KVVersion: 1, PathPrefix: "/kloops/"},
+			namespace: "team-a",
+			path:      "/github",
+			key:       "token",
+			want:      "kv1-token",
+			wantPath:  "/v1/kv/kloops/team-a/github",
+		},
+		{
+			name:      "other namespace",
+			vault:     Vault{PathPrefix: "kloops"},
+			namespace: "team-b",
+			path:      "github",
+			key:       "token",
+			wantErr:   "not found",
+			wantPath:  "/v1/secret/data/kloops/team-b/github",
+		},
+		{
+			name:      "parent path",
+			vault:     Vault{PathPrefix: "kloops"},
+			namespace: "team-b",
+			path:      "../team-a/github",
+			key:       "token",
+			wantErr:   "must not contain",
+		},
+		{
+			name:      "escaped path",
+			vault:     Vault{PathPrefix: "kloops"},
+			namespace: "team-b",
+			path:      "github?x=1",
+			key:       "token",
+			wantErr:   "not found",
+			wantPath:  "/v1/secret/data/kloops/team-b/github%3Fx=1",
+		},
+		{
+			name:    "no namespace",
+			vault:   Vault{PathPrefix: "kloops"},
+			path:    "team-a/github",
+			key:     "token",
+			wantErr: "must be read for a namespace",
+		},
+		{
+			name:      "missing key",
+			vault:     Vault{PathPrefix: "kloops"},
+			namespace: "team-a",
+			path:      "github",
+			key:       "password",
+			wantErr:   "key password not found",
+			wantPath:  "/v1/secret/data/kloops/team-a/github",
+		},
+		{
+			name:      "not a string",
+			vault:     Vault{PathPrefix: "kloops"},
+			namespace: "team-a",
+			path:      "github",
+			key:       "count",
+			wantErr:   "is not a string",
+			wantPath:  "/v1/secret/data/kloops/team-a/github",
+		},
+		{
+			name:      "invalid token",
+			vault:     Vault{PathPrefix: "kloops", Token: "other"},
+			namespace: "team-a",
+			path:      "github",
+			key:       "token",
+			wantErr:   "status 403",
+			wantPath:  "/v1/secret/data/kloops/team-a/github",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*requests = nil
+			vault := tt.vault
+			vault.Address = server.URL + "/"
+			if vault.Token == "" {
+				vault.Token = "vault-token"
+			}
+			got, err := vault.Get(context.Background(), tt.namespace, tt.path, tt.key)
+			switch {
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Errorf("Get() error = %v, want %q", err, tt.wantErr)
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("Get() error = %v", err)
+			case got != tt.want:
+				t.Errorf("Get() = %q, want %q", got, tt.want)
+			}
+			if tt.wantPath != "" && (len(*requests) != 1 || (*requests)[0] != tt.wantPath) {
+				t.Errorf("requests = %v, want %s", *requests, tt.wantPath)
+			}
+			if tt.wantPath == "" && len(*requests) != 0 {
+				t.Errorf("unexpected requests %v", *requests)
+			}
+		})
+	}
+}
+
+func TestGetExternalScopesNamespace(t *testing.T) {
+	server, requests := fakeVault(t, map[string]interface{}{})
+	RegisterProvider("test-vault", &Vault{Address: server.URL, Token: "vault-token", PathPrefix: "kloops"})
+	_, _ = getExternal(context.Background(), "team-a", v1alpha1.ExternalRef{Provider: "test-vault", Path: "github", Key: "token"})
+	if want := []string{"/v1/secret/data/kloops/team-a/github"}; len(*requests) != 1 || (*requests)[0] != want[0] {
+		t.Errorf("requests = %v, want %v", *requests, want)
+	}
+}
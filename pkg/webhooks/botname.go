@@ -0,0 +1,101 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhooks contains the admission webhooks that need access to the cluster or git servers.
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/kloops-io/kloops/apis/config/v1alpha1"
+	"github.com/kloops-io/kloops/pkg/scm"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// BotNamePath is the path the bot name defaulting webhook is served on
+const BotNamePath = "/mutate-config-kloops-io-v1alpha1-repoconfig-botname"
+
+// +kubebuilder:webhook:path=/mutate-config-kloops-io-v1alpha1-repoconfig-botname,mutating=true,failurePolicy=ignore,groups=config.kloops.io,resources=repoconfigs,verbs=create;update,versions=v1alpha1,name=mrepoconfig-botname.kloops.io
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// DefaultBotNameTimeout bounds the git server calls resolving the bot name, well below the api server webhook timeout
+const DefaultBotNameTimeout = 3 * time.Second
+
+// BotNameDefaulter defaults the RepoConfig bot name to the login of the user owning the repository token
+type BotNameDefaulter struct {
+	Client client.Reader
+	// Timeout bounds the resolution of the bot name, defaults to DefaultBotNameTimeout
+	Timeout time.Duration
+	decoder *admission.Decoder
+}
+
+// SetupWithManager registers the webhook with the manager webhook server
+func (d *BotNameDefaulter) SetupWithManager(mgr ctrl.Manager) {
+	mgr.GetWebhookServer().Register(BotNamePath, &webhook.Admission{Handler: d})
+}
+
+// Handle implements admission.Handler
+func (d *BotNameDefaulter) Handle(ctx context.Context, req admission.Request) admission.Response {
+	var repoConfig v1alpha1.RepoConfig
+	if err := d.decoder.Decode(req, &repoConfig); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if repoConfig.Spec.BotName != "" {
+		return admission.Allowed("bot name is set")
+	}
+	if repoConfig.Spec.OrgConfig != "" {
+		return admission.Allowed("bot name is inherited from the org config")
+	}
+	botName, err := d.botName(ctx, req.Namespace, &repoConfig.Spec)
+	if err != nil {
+		// not being able to default the bot name must not prevent saving the repo config
+		return admission.Allowed(fmt.Sprintf("failed to resolve bot name: %s", err))
+	}
+	repoConfig.Spec.BotName = botName
+	marshaled, err := json.Marshal(&repoConfig)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
+}
+
+// InjectDecoder implements admission.DecoderInjector
+func (d *BotNameDefaulter) InjectDecoder(decoder *admission.Decoder) error {
+	d.decoder = decoder
+	return nil
+}
+
+func (d *BotNameDefaulter) botName(ctx context.Context, namespace string, spec *v1alpha1.RepoConfigSpec) (string, error) {
+	// a slow git server must not stall the admission of the repo config
+	timeout := d.Timeout
+	if timeout == 0 {
+		timeout = DefaultBotNameTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	scmClient, err := scm.NewClientFromSpec(ctx, d.Client, namespace, spec)
+	if err != nil {
+		return "", err
+	}
+	return scmClient.CurrentUser(ctx)
+}
@@ -0,0 +1,46 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kloops-io/kloops/apis/config/v1alpha1"
+)
+
+func TestBotNameTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	d := &BotNameDefaulter{Timeout: 50 * time.Millisecond}
+	spec := &v1alpha1.RepoConfigSpec{Gitea: &v1alpha1.GiteaRepo{Owner: "owner", Repo: "repo", ServerURL: server.URL, Token: v1alpha1.Secret{Value: "token"}}}
+	start := time.Now()
+	if _, err := d.botName(context.Background(), "ns", spec); err == nil {
+		t.Fatal("botName() succeeded against a stalled git server")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("botName() returned after %s, want about the timeout", elapsed)
+	}
+}
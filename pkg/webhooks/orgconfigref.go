@@ -0,0 +1,77 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/kloops-io/kloops/apis/config/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// OrgConfigRefPath is the path the org config reference validating webhook is served on
+const OrgConfigRefPath = "/validate-config-kloops-io-v1alpha1-repoconfig-orgconfig"
+
+// +kubebuilder:webhook:path=/validate-config-kloops-io-v1alpha1-repoconfig-orgconfig,mutating=false,failurePolicy=fail,groups=config.kloops.io,resources=repoconfigs,verbs=create;update,versions=v1alpha1,name=vrepoconfig-orgconfig.kloops.io
+// +kubebuilder:rbac:groups=config.kloops.io,resources=orgconfigs,verbs=get;list;watch
+
+// OrgConfigRefValidator denies repo configs referencing an org config that does not apply to their repository,
+// the git server must be the same and the repository must be matched by the org config.
+// References to missing org configs are allowed, they are reported in the repo config status.
+type OrgConfigRefValidator struct {
+	Client  client.Reader
+	decoder *admission.Decoder
+}
+
+// SetupWithManager registers the webhook with the manager webhook server
+func (v *OrgConfigRefValidator) SetupWithManager(mgr ctrl.Manager) {
+	mgr.GetWebhookServer().Register(OrgConfigRefPath, &webhook.Admission{Handler: v})
+}
+
+// Handle implements admission.Handler
+func (v *OrgConfigRefValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	var repoConfig v1alpha1.RepoConfig
+	if err := v.decoder.Decode(req, &repoConfig); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if repoConfig.Spec.OrgConfig == "" {
+		return admission.Allowed("no org config reference")
+	}
+	var orgConfig v1alpha1.OrgConfig
+	if err := v.Client.Get(ctx, types.NamespacedName{Namespace: req.Namespace, Name: repoConfig.Spec.OrgConfig}, &orgConfig); err != nil {
+		if apierrors.IsNotFound(err) {
+			return admission.Allowed("org config not found")
+		}
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if err := orgConfig.Spec.CheckRepo(&repoConfig.Spec); err != nil {
+		return admission.Denied("spec.orgConfig: " + err.Error())
+	}
+	return admission.Allowed("org config applies to the repository")
+}
+
+// InjectDecoder implements admission.DecoderInjector
+func (v *OrgConfigRefValidator) InjectDecoder(decoder *admission.Decoder) error {
+	v.decoder = decoder
+	return nil
+}
@@ -0,0 +1,120 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/kloops-io/kloops/apis/config/v1alpha1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// PluginConfigRefPath is the path the plugin config reference validating webhook is served on
+const PluginConfigRefPath = "/validate-config-kloops-io-v1alpha1-pluginconfigref"
+
+// +kubebuilder:webhook:path=/validate-config-kloops-io-v1alpha1-pluginconfigref,mutating=false,failurePolicy=fail,groups=config.kloops.io,resources=repoconfigs;orgconfigs,verbs=create;update,versions=v1alpha1,name=vpluginconfigref.kloops.io
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+
+// PluginConfigRefValidator denies references to plugin configs of other namespaces, or cluster plugin configs,
+// the requesting user is not allowed to read.
+// It prevents users from using the controller permissions to read plugin configs they don't have access to.
+type PluginConfigRefValidator struct {
+	Client client.Client
+}
+
+// SetupWithManager registers the webhook with the manager webhook server
+func (v *PluginConfigRefValidator) SetupWithManager(mgr ctrl.Manager) {
+	mgr.GetWebhookServer().Register(PluginConfigRefPath, &webhook.Admission{Handler: v})
+}
+
+// pluginConfigHolder decodes the plugin config of RepoConfig and OrgConfig objects
+type pluginConfigHolder struct {
+	Spec struct {
+		PluginConfig v1alpha1.RepoPluginConfig `json:"pluginConfig"`
+	} `json:"spec"`
+}
+
+// Handle implements admission.Handler
+func (v *PluginConfigRefValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	var obj pluginConfigHolder
+	if err := json.Unmarshal(req.Object.Raw, &obj); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	// references already allowed are not reviewed again
+	existing := map[string]bool{}
+	if len(req.OldObject.Raw) != 0 {
+		var old pluginConfigHolder
+		if err := json.Unmarshal(req.OldObject.Raw, &old); err == nil {
+			for _, ref := range old.Spec.PluginConfig.AllRefs() {
+				existing[ref.Describe(req.Namespace)] = true
+			}
+		}
+	}
+	for _, ref := range obj.Spec.PluginConfig.AllRefs() {
+		if ref.Key(req.Namespace).Namespace == req.Namespace || existing[ref.Describe(req.Namespace)] {
+			continue
+		}
+		allowed, err := v.canGet(ctx, req.UserInfo, req.Namespace, ref)
+		if err != nil {
+			return admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to review access to %s: %w", ref.Describe(req.Namespace), err))
+		}
+		if !allowed {
+			return admission.Denied(fmt.Sprintf("user %s is not allowed to get %s", req.UserInfo.Username, ref.Describe(req.Namespace)))
+		}
+	}
+	return admission.Allowed("plugin config references are allowed")
+}
+
+func (v *PluginConfigRefValidator) canGet(ctx context.Context, user authenticationv1.UserInfo, namespace string, ref v1alpha1.PluginConfigRef) (bool, error) {
+	resource := "pluginconfigs"
+	if ref.RefKind() == v1alpha1.ClusterPluginConfigKind {
+		resource = "clusterpluginconfigs"
+	}
+	key := ref.Key(namespace)
+	review := authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   user.Username,
+			Groups: user.Groups,
+			UID:    user.UID,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: key.Namespace,
+				Verb:      "get",
+				Group:     v1alpha1.GroupVersion.Group,
+				Resource:  resource,
+				Name:      key.Name,
+			},
+		},
+	}
+	for name, values := range user.Extra {
+		if review.Spec.Extra == nil {
+			review.Spec.Extra = map[string]authorizationv1.ExtraValue{}
+		}
+		review.Spec.Extra[name] = authorizationv1.ExtraValue(values)
+	}
+	if err := v.Client.Create(ctx, &review); err != nil {
+		return false, err
+	}
+	return review.Status.Allowed, nil
+}
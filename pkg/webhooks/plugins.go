@@ -0,0 +1,54 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/kloops-io/kloops/pkg/plugins"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// PluginsPath is the path the plugins validating webhook is served on
+const PluginsPath = "/validate-config-kloops-io-v1alpha1-plugins"
+
+// +kubebuilder:webhook:path=/validate-config-kloops-io-v1alpha1-plugins,mutating=false,failurePolicy=fail,groups=config.kloops.io,resources=repoconfigs;orgconfigs,verbs=create;update,versions=v1alpha1,name=vplugins.kloops.io
+
+// PluginsValidator denies repo configs and org configs enabling, disabling or restricting plugins unknown to the plugin registry
+type PluginsValidator struct{}
+
+// SetupWithManager registers the webhook with the manager webhook server
+func (v *PluginsValidator) SetupWithManager(mgr ctrl.Manager) {
+	mgr.GetWebhookServer().Register(PluginsPath, &webhook.Admission{Handler: v})
+}
+
+// Handle implements admission.Handler
+func (v *PluginsValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	var obj pluginConfigHolder
+	if err := json.Unmarshal(req.Object.Raw, &obj); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if errs := plugins.Validate(field.NewPath("spec", "pluginConfig"), &obj.Spec.PluginConfig); len(errs) != 0 {
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+	return admission.Allowed("plugins are known")
+}